@@ -0,0 +1,169 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the tracking of rounds to fetch messages from, based on the client
+// bloom filters returned by successive polls
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/id/ephemeral"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MaxFilterRoundRange is the largest number of rounds, after the first, that a
+// client bloom filter may cover. AddPoll rejects polls with larger filters
+// rather than expanding them into rounds to fetch.
+const MaxFilterRoundRange = 1 << 16
+
+// Error messages.
+const (
+	matchBloomFiltersErr = "failed to match client bloom filters"
+	filterTooLargeErr    = "client bloom filter for rounds %d to %d covers more than %d rounds"
+)
+
+// BloomRounds merges the rounds in which an ephemeral ID may have received
+// messages, according to the client bloom filters of successive polls, into a
+// list of rounds to request messages from with RequestMessages.
+//
+// Successive polls return overlapping filters, so a round is only returned
+// once, until it is marked as fetched with Fetched. Fetched rounds are
+// remembered until they are older than every filter in the latest poll, so
+// that they are not returned again.
+type BloomRounds struct {
+	ephID  ephemeral.Id
+	start  time.Time
+	end    time.Time
+	decode pb.BloomFilterDecoder
+
+	pending map[id.Round]struct{}
+	fetched map[id.Round]struct{}
+	mux     sync.Mutex
+}
+
+// NewBloomRounds returns a BloomRounds for the ephemeral ID, which is valid
+// from start to end. Filters are decoded with the decoder.
+func NewBloomRounds(ephID ephemeral.Id, start, end time.Time,
+	decode pb.BloomFilterDecoder) *BloomRounds {
+	return &BloomRounds{
+		ephID:   ephID,
+		start:   start,
+		end:     end,
+		decode:  decode,
+		pending: make(map[id.Round]struct{}),
+		fetched: make(map[id.Round]struct{}),
+	}
+}
+
+// AddPoll adds the rounds matched by the filters in the poll response. It
+// returns the number of rounds added that were not already pending or fetched.
+// Returns an error, without adding any rounds, if a matching filter covers
+// more than MaxFilterRoundRange rounds.
+func (br *BloomRounds) AddPoll(response *pb.GatewayPollResponse) (int, error) {
+	filters := response.GetFilters()
+	matches, err := filters.Match(br.ephID, br.start, br.end, br.decode)
+	if err != nil {
+		return 0, errors.WithMessage(err, matchBloomFiltersErr)
+	}
+	for _, match := range matches {
+		if match.GetRoundRange() > MaxFilterRoundRange {
+			first, last := match.GetRounds()
+			return 0, errors.Errorf(
+				filterTooLargeErr, first, last, MaxFilterRoundRange)
+		}
+	}
+
+	br.mux.Lock()
+	defer br.mux.Unlock()
+
+	// Forget fetched rounds older than every filter, as no later poll can
+	// return them
+	if len(filters.GetFilters()) > 0 {
+		oldest, _ := filters.GetFilters()[0].GetRounds()
+		for _, filter := range filters.GetFilters()[1:] {
+			if first, _ := filter.GetRounds(); first < oldest {
+				oldest = first
+			}
+		}
+		for round := range br.fetched {
+			if round < oldest {
+				delete(br.fetched, round)
+			}
+		}
+	}
+
+	added := 0
+	for _, match := range matches {
+		// Stop at the last round rather than after it, which would overflow
+		// when the last round is the largest
+		first, last := match.GetRounds()
+		for round := first; ; round++ {
+			_, fetched := br.fetched[round]
+			if _, pending := br.pending[round]; !fetched && !pending {
+				br.pending[round] = struct{}{}
+				added++
+			}
+			if round == last {
+				break
+			}
+		}
+	}
+
+	jww.TRACE.Printf("Added %d rounds to fetch from %d matching bloom "+
+		"filters for %d", added, len(matches), br.ephID.Int64())
+
+	return added, nil
+}
+
+// Rounds returns the rounds to fetch, in ascending order.
+func (br *BloomRounds) Rounds() []id.Round {
+	br.mux.Lock()
+	defer br.mux.Unlock()
+
+	rounds := make([]id.Round, 0, len(br.pending))
+	for round := range br.pending {
+		rounds = append(rounds, round)
+	}
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i] < rounds[j] })
+
+	return rounds
+}
+
+// Requests returns a RequestMessages request for each round to fetch, in
+// ascending round order, addressed to the target gateway. The target may be
+// nil, to be filled in once a gateway is chosen.
+func (br *BloomRounds) Requests(target *id.ID) []*pb.GetMessages {
+	rounds := br.Rounds()
+	requests := make([]*pb.GetMessages, len(rounds))
+	for i, round := range rounds {
+		ephID := br.ephID
+		requests[i] = &pb.GetMessages{
+			ClientID: ephID[:],
+			RoundID:  uint64(round),
+		}
+		if target != nil {
+			requests[i].Target = target.Bytes()
+		}
+	}
+
+	return requests
+}
+
+// Fetched marks the round as fetched, so that it is no longer returned.
+func (br *BloomRounds) Fetched(round id.Round) {
+	br.mux.Lock()
+	defer br.mux.Unlock()
+
+	delete(br.pending, round)
+	br.fetched[round] = struct{}{}
+}
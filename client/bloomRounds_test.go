@@ -0,0 +1,172 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"bytes"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/id/ephemeral"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testBloomFilter is an exact filter that contains its encoding.
+type testBloomFilter []byte
+
+func (f testBloomFilter) Test(data []byte) bool {
+	return bytes.Equal(f, data)
+}
+
+func decodeTestBloomFilter(filter []byte) (pb.BloomFilter, error) {
+	return testBloomFilter(filter), nil
+}
+
+// newTestPoll returns a poll response with a one minute filter for each first
+// round, which contains the ephemeral ID if it is in matches.
+func newTestPoll(first time.Time, ephID ephemeral.Id,
+	firstRounds []uint64, matches map[uint64]bool) *pb.GatewayPollResponse {
+	filters := &pb.ClientBlooms{
+		Period:         int64(time.Minute),
+		FirstTimestamp: first.UnixNano(),
+	}
+	for _, round := range firstRounds {
+		filter := &pb.ClientBloom{FirstRound: round, RoundRange: 1}
+		if matches[round] {
+			filter.Filter = ephID[:]
+		}
+		filters.Filters = append(filters.Filters, filter)
+	}
+
+	return &pb.GatewayPollResponse{Filters: filters}
+}
+
+// Tests that BloomRounds merges overlapping polls and does not return fetched
+// rounds again.
+func TestBloomRounds(t *testing.T) {
+	ephID := ephemeral.Id{1, 2, 3, 4, 5, 6, 7, 8}
+	start := time.Unix(1000, 0)
+	br := NewBloomRounds(ephID, start, start.Add(time.Hour),
+		decodeTestBloomFilter)
+
+	added, err := br.AddPoll(newTestPoll(start, ephID, []uint64{10, 12, 14},
+		map[uint64]bool{10: true, 14: true}))
+	if err != nil {
+		t.Fatalf("AddPoll error: %+v", err)
+	}
+	if expected := []id.Round{10, 11, 14, 15}; added != 4 ||
+		!reflect.DeepEqual(br.Rounds(), expected) {
+		t.Errorf("Expected rounds %v, received %v (%d added)",
+			expected, br.Rounds(), added)
+	}
+
+	br.Fetched(10)
+	br.Fetched(11)
+
+	// The next poll overlaps the first
+	added, err = br.AddPoll(newTestPoll(start.Add(time.Minute), ephID,
+		[]uint64{10, 12, 14, 16}, map[uint64]bool{10: true, 14: true, 16: true}))
+	if err != nil {
+		t.Fatalf("AddPoll error: %+v", err)
+	}
+	if expected := []id.Round{14, 15, 16, 17}; added != 2 ||
+		!reflect.DeepEqual(br.Rounds(), expected) {
+		t.Errorf("Expected rounds %v, received %v (%d added)",
+			expected, br.Rounds(), added)
+	}
+
+	// Fetched rounds older than every filter are forgotten
+	_, err = br.AddPoll(newTestPoll(start.Add(2*time.Minute), ephID,
+		[]uint64{14, 16}, nil))
+	if err != nil {
+		t.Fatalf("AddPoll error: %+v", err)
+	}
+	if len(br.fetched) != 0 {
+		t.Errorf("Fetched rounds not forgotten: %v", br.fetched)
+	}
+}
+
+// Tests that BloomRounds ignores filters outside the ephemeral ID's validity.
+func TestBloomRounds_AddPoll_OutsideValidity(t *testing.T) {
+	ephID := ephemeral.Id{1, 2, 3, 4, 5, 6, 7, 8}
+	start := time.Unix(1000, 0)
+	br := NewBloomRounds(ephID, start.Add(time.Minute),
+		start.Add(2*time.Minute), decodeTestBloomFilter)
+
+	_, err := br.AddPoll(newTestPoll(start, ephID, []uint64{10, 12, 14},
+		map[uint64]bool{10: true, 12: true, 14: true}))
+	if err != nil {
+		t.Fatalf("AddPoll error: %+v", err)
+	}
+	if expected := []id.Round{12, 13}; !reflect.DeepEqual(br.Rounds(), expected) {
+		t.Errorf("Expected rounds %v, received %v", expected, br.Rounds())
+	}
+}
+
+// Tests that BloomRounds.Requests returns a request for each round to fetch.
+func TestBloomRounds_Requests(t *testing.T) {
+	ephID := ephemeral.Id{1, 2, 3, 4, 5, 6, 7, 8}
+	start := time.Unix(1000, 0)
+	br := NewBloomRounds(ephID, start, start.Add(time.Hour),
+		decodeTestBloomFilter)
+	_, err := br.AddPoll(newTestPoll(start, ephID, []uint64{10},
+		map[uint64]bool{10: true}))
+	if err != nil {
+		t.Fatalf("AddPoll error: %+v", err)
+	}
+
+	target := id.NewIdFromString("gateway", id.Gateway, t)
+	requests := br.Requests(target)
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 requests, received %d", len(requests))
+	}
+	for i, request := range requests {
+		if request.RoundID != uint64(10+i) ||
+			!bytes.Equal(request.ClientID, ephID[:]) ||
+			!bytes.Equal(request.Target, target.Bytes()) {
+			t.Errorf("Unexpected request %d: %v", i, request)
+		}
+	}
+
+	if requests = br.Requests(nil); requests[0].Target != nil {
+		t.Errorf("Target set without target: %v", requests[0])
+	}
+}
+
+// Error path: Tests that BloomRounds.AddPoll rejects a matching filter covering
+// more than MaxFilterRoundRange rounds without adding any rounds, and expands
+// a filter ending at the largest round without overflowing.
+func TestBloomRounds_AddPoll_RoundRange(t *testing.T) {
+	ephID := ephemeral.Id{1, 2, 3, 4, 5, 6, 7, 8}
+	start := time.Unix(1000, 0)
+	br := NewBloomRounds(ephID, start, start.Add(time.Hour),
+		decodeTestBloomFilter)
+
+	poll := newTestPoll(start, ephID, []uint64{10, 12},
+		map[uint64]bool{10: true, 12: true})
+	poll.Filters.Filters[1].RoundRange = math.MaxUint32
+	if _, err := br.AddPoll(poll); err == nil {
+		t.Error("No error for filter covering too many rounds")
+	}
+	if rounds := br.Rounds(); len(rounds) != 0 {
+		t.Errorf("Rounds added from rejected poll: %v", rounds)
+	}
+
+	poll = newTestPoll(start, ephID, []uint64{math.MaxUint64 - 1},
+		map[uint64]bool{math.MaxUint64 - 1: true})
+	poll.Filters.Filters[0].RoundRange = 5
+	if _, err := br.AddPoll(poll); err != nil {
+		t.Fatalf("AddPoll error: %+v", err)
+	}
+	expected := []id.Round{math.MaxUint64 - 1, math.MaxUint64}
+	if !reflect.DeepEqual(br.Rounds(), expected) {
+		t.Errorf("Expected rounds %v, received %v", expected, br.Rounds())
+	}
+}
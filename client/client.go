@@ -13,11 +13,15 @@ import (
 	"github.com/pkg/errors"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
+	"sync"
 )
 
 // Client object used to implement endpoints and top-level comms functionality
 type Comms struct {
 	*connect.ProtoComms
+
+	retryPolicies RetryPolicies
+	retryMux      sync.RWMutex
 }
 
 // Returns a Comms object with given attributes
@@ -26,5 +30,8 @@ func NewClientComms(id *id.ID, pubKeyPem, privKeyPem, salt []byte) (*Comms, erro
 	if err != nil {
 		return nil, errors.Errorf("Unable to create Client comms: %+v", err)
 	}
-	return &Comms{pc}, nil
+	return &Comms{
+		ProtoComms:    pc,
+		retryPolicies: DefaultRetryPolicies(),
+	}, nil
 }
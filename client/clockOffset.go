@@ -0,0 +1,297 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains an NTP style estimator of the local clock's offset from the
+// gateways' clocks, built on the timestamps in poll responses
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Error messages.
+const (
+	noReceivedTsErr       = "poll response has no received timestamp"
+	negativeDelayErr      = "gateway delay %s exceeds round trip time %s"
+	notEnoughSamplesErr   = "%d clock samples, need at least %d"
+	notEnoughInliersErr   = "%d of %d clock samples are outliers"
+	offsetBoundTooHighErr = "clock offset bound %s exceeds maximum %s"
+)
+
+// minOutlierSpread is the minimum deviation from the median offset used for
+// outlier rejection, so that a set of nearly identical samples does not reject
+// samples that differ by only a few microseconds.
+const minOutlierSpread = time.Millisecond
+
+// ClockOffsetParams contains the parameters of a ClockOffsetEstimator.
+type ClockOffsetParams struct {
+	// Number of most recent samples used for the estimate
+	MaxSamples int
+
+	// Minimum number of samples, after outlier rejection, needed for an
+	// estimate
+	MinSamples int
+
+	// Samples whose offset differs from the median by more than this many
+	// median absolute deviations are rejected as outliers
+	OutlierThreshold float64
+
+	// Samples whose network delay exceeds this are discarded, as their offset
+	// is too uncertain to be useful
+	MaxDelay time.Duration
+}
+
+// DefaultClockOffsetParams returns the default ClockOffsetParams.
+func DefaultClockOffsetParams() ClockOffsetParams {
+	return ClockOffsetParams{
+		MaxSamples:       64,
+		MinSamples:       3,
+		OutlierThreshold: 3,
+		MaxDelay:         5 * time.Second,
+	}
+}
+
+// ClockOffset is an estimate of the offset of the network time from the local
+// clock. Network time is local time plus Offset, give or take Bound.
+type ClockOffset struct {
+	Offset time.Duration
+	Bound  time.Duration
+
+	// Number of samples and distinct gateways the estimate is based on, after
+	// outlier rejection
+	NumSamples  int
+	NumGateways int
+}
+
+// ClockOffsetEstimator estimates the offset of the local clock from the
+// gateways' clocks using the timestamps in the responses to successive polls,
+// in the same way as NTP.
+//
+// For each poll, the local send time t0 and round trip time are returned by
+// SendPoll, and the gateway reports the time it received the poll, t1, and how
+// long it spent on it. The offset of the sample is the difference between t1
+// and the local time halfway through the network part of the round trip, and
+// its error is at most half of that network delay.
+//
+// The estimator implements netTime.TimeSource, returning the local time
+// corrected by the last estimate accepted by Apply. Pass it to
+// netTime.SetTimeSource to correct netTime.Now.
+type ClockOffsetEstimator struct {
+	params  ClockOffsetParams
+	samples []clockSample
+	next    int
+	mux     sync.Mutex
+
+	// Offset in nanoseconds applied by NowMs
+	applied int64
+}
+
+// clockSample is the offset measured by a single poll.
+type clockSample struct {
+	gateway id.ID
+	offset  time.Duration
+	delay   time.Duration
+}
+
+// NewClockOffsetEstimator returns a ClockOffsetEstimator with no samples.
+// Unset parameters take their default values, except MinSamples, which is at
+// least one.
+func NewClockOffsetEstimator(params ClockOffsetParams) *ClockOffsetEstimator {
+	defaults := DefaultClockOffsetParams()
+	if params.MaxSamples <= 0 {
+		params.MaxSamples = defaults.MaxSamples
+	}
+	if params.MinSamples < 1 {
+		params.MinSamples = 1
+	}
+	if params.OutlierThreshold <= 0 {
+		params.OutlierThreshold = defaults.OutlierThreshold
+	}
+	if params.MaxDelay <= 0 {
+		params.MaxDelay = defaults.MaxDelay
+	}
+	return &ClockOffsetEstimator{
+		params:  params,
+		samples: make([]clockSample, 0, params.MaxSamples),
+	}
+}
+
+// AddSample adds the sample from a poll of the gateway, given the response and
+// the send time and round trip time returned by SendPoll. Once MaxSamples
+// samples have been added, each new sample replaces the oldest.
+func (e *ClockOffsetEstimator) AddSample(gatewayID *id.ID,
+	response *pb.GatewayPollResponse, sendTime time.Time,
+	rtt time.Duration) error {
+	if response.GetReceivedTs() == 0 {
+		return errors.New(noReceivedTsErr)
+	}
+
+	gatewayDelay := time.Duration(response.GetGatewayDelay())
+	if gatewayDelay > rtt {
+		return errors.Errorf(negativeDelayErr, gatewayDelay, rtt)
+	}
+
+	// Network delay of the round trip, excluding the time spent in the gateway
+	delay := rtt - gatewayDelay
+	if delay > e.params.MaxDelay {
+		jww.DEBUG.Printf("Discarding clock sample from %s with delay %s",
+			gatewayID, delay)
+		return nil
+	}
+
+	// With t0 and t3 the local send and receive times and t1 and t2 the
+	// gateway receive and send times, offset = ((t1 - t0) + (t2 - t3)) / 2,
+	// which simplifies to t1 - (t0 + delay/2)
+	received := time.Unix(0, response.GetReceivedTs())
+	sample := clockSample{
+		gateway: *gatewayID,
+		offset:  received.Sub(sendTime.Add(delay / 2)),
+		delay:   delay,
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	if len(e.samples) < e.params.MaxSamples {
+		e.samples = append(e.samples, sample)
+	} else {
+		e.samples[e.next] = sample
+		e.next = (e.next + 1) % e.params.MaxSamples
+	}
+
+	return nil
+}
+
+// Estimate returns the current estimate of the clock offset. Samples whose
+// offset is far from the median are rejected as outliers, such as those from a
+// gateway with a bad clock. The remaining offsets are averaged, weighted
+// towards samples with lower network delay. The bound is the error bound of
+// the best sample plus the spread of the remaining offsets.
+func (e *ClockOffsetEstimator) Estimate() (ClockOffset, error) {
+	e.mux.Lock()
+	samples := make([]clockSample, len(e.samples))
+	copy(samples, e.samples)
+	e.mux.Unlock()
+
+	if len(samples) == 0 || len(samples) < e.params.MinSamples {
+		return ClockOffset{}, errors.Errorf(
+			notEnoughSamplesErr, len(samples), e.params.MinSamples)
+	}
+
+	inliers := rejectOutliers(samples, e.params.OutlierThreshold)
+	if len(inliers) < e.params.MinSamples {
+		return ClockOffset{}, errors.Errorf(
+			notEnoughInliersErr, len(samples)-len(inliers), len(samples))
+	}
+
+	var sum, weights float64
+	minDelay := inliers[0].delay
+	gateways := make(map[id.ID]struct{})
+	for _, s := range inliers {
+		weight := 1 / float64(s.delay+time.Millisecond)
+		sum += weight * float64(s.offset)
+		weights += weight
+		if s.delay < minDelay {
+			minDelay = s.delay
+		}
+		gateways[s.gateway] = struct{}{}
+	}
+	offset := sum / weights
+
+	var variance float64
+	for _, s := range inliers {
+		diff := float64(s.offset) - offset
+		variance += diff * diff
+	}
+	spread := math.Sqrt(variance / float64(len(inliers)))
+
+	return ClockOffset{
+		Offset:      time.Duration(offset),
+		Bound:       minDelay/2 + time.Duration(spread),
+		NumSamples:  len(inliers),
+		NumGateways: len(gateways),
+	}, nil
+}
+
+// Apply sets the offset used by NowMs to the current estimate if its bound is
+// no more than maxBound. The estimate is returned.
+func (e *ClockOffsetEstimator) Apply(
+	maxBound time.Duration) (ClockOffset, error) {
+	estimate, err := e.Estimate()
+	if err != nil {
+		return ClockOffset{}, err
+	}
+
+	if estimate.Bound > maxBound {
+		return estimate, errors.Errorf(
+			offsetBoundTooHighErr, estimate.Bound, maxBound)
+	}
+
+	atomic.StoreInt64(&e.applied, int64(estimate.Offset))
+	return estimate, nil
+}
+
+// NowMs returns the local time, corrected by the offset set by Apply, in
+// milliseconds since the Unix epoch.
+func (e *ClockOffsetEstimator) NowMs() int64 {
+	now := time.Now().Add(time.Duration(atomic.LoadInt64(&e.applied)))
+	return now.UnixNano() / int64(time.Millisecond)
+}
+
+// rejectOutliers returns the samples whose offset is within threshold median
+// absolute deviations of the median offset.
+func rejectOutliers(samples []clockSample, threshold float64) []clockSample {
+	offsets := make([]time.Duration, len(samples))
+	for i, s := range samples {
+		offsets[i] = s.offset
+	}
+	median := medianDuration(offsets)
+
+	deviations := make([]time.Duration, len(samples))
+	for i, s := range samples {
+		deviations[i] = absDuration(s.offset - median)
+	}
+	spread := medianDuration(deviations)
+	if spread < minOutlierSpread {
+		spread = minOutlierSpread
+	}
+
+	limit := time.Duration(threshold * float64(spread))
+	inliers := make([]clockSample, 0, len(samples))
+	for _, s := range samples {
+		if absDuration(s.offset-median) <= limit {
+			inliers = append(inliers, s)
+		}
+	}
+
+	return inliers
+}
+
+// medianDuration returns the median of the durations. The slice is sorted.
+func medianDuration(d []time.Duration) time.Duration {
+	sort.Slice(d, func(i, j int) bool { return d[i] < d[j] })
+	if len(d)%2 == 1 {
+		return d[len(d)/2]
+	}
+	return (d[len(d)/2-1] + d[len(d)/2]) / 2
+}
+
+// absDuration returns the absolute value of the duration.
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,154 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/netTime"
+	"testing"
+	"time"
+)
+
+// addTestSample adds a sample from a poll sent at sendTime to a gateway whose
+// clock is offset from the local clock, with the given network delay each way
+// and time spent in the gateway.
+func addTestSample(t *testing.T, e *ClockOffsetEstimator, gatewayID *id.ID,
+	sendTime time.Time, offset, oneWay, gatewayDelay time.Duration) {
+	response := &pb.GatewayPollResponse{
+		ReceivedTs:   sendTime.Add(oneWay + offset).UnixNano(),
+		GatewayDelay: int64(gatewayDelay),
+	}
+	rtt := 2*oneWay + gatewayDelay
+	if err := e.AddSample(gatewayID, response, sendTime, rtt); err != nil {
+		t.Fatalf("Failed to add sample: %+v", err)
+	}
+}
+
+// Tests that ClockOffsetEstimator.Estimate recovers the offset of the gateways'
+// clocks and rejects the samples of a gateway with a bad clock.
+func TestClockOffsetEstimator_Estimate(t *testing.T) {
+	e := NewClockOffsetEstimator(DefaultClockOffsetParams())
+	gateways := []*id.ID{
+		id.NewIdFromString("gw0", id.Gateway, t),
+		id.NewIdFromString("gw1", id.Gateway, t),
+		id.NewIdFromString("gw2", id.Gateway, t),
+	}
+	badGateway := id.NewIdFromString("bad", id.Gateway, t)
+
+	offset := 2 * time.Second
+	sendTime := time.Unix(1700000000, 0)
+	for i := 0; i < 10; i++ {
+		oneWay := time.Duration(20+i*5) * time.Millisecond
+		addTestSample(t, e, gateways[i%len(gateways)], sendTime, offset,
+			oneWay, 30*time.Millisecond)
+		sendTime = sendTime.Add(time.Second)
+	}
+	addTestSample(t, e, badGateway, sendTime, time.Minute, 20*time.Millisecond, 0)
+
+	estimate, err := e.Estimate()
+	if err != nil {
+		t.Fatalf("Estimate error: %+v", err)
+	}
+	if estimate.NumSamples != 10 || estimate.NumGateways != len(gateways) {
+		t.Errorf("Outlier not rejected: %+v", estimate)
+	}
+	if diff := absDuration(estimate.Offset - offset); diff > estimate.Bound {
+		t.Errorf("Offset %s not within %s of %s.",
+			estimate.Offset, estimate.Bound, offset)
+	}
+	if estimate.Bound > 25*time.Millisecond {
+		t.Errorf("Bound larger than best sample error: %s", estimate.Bound)
+	}
+}
+
+// Tests that ClockOffsetEstimator.AddSample rejects invalid samples and keeps
+// only the most recent samples.
+func TestClockOffsetEstimator_AddSample(t *testing.T) {
+	params := DefaultClockOffsetParams()
+	params.MaxSamples = 3
+	e := NewClockOffsetEstimator(params)
+	gatewayID := id.NewIdFromString("gw", id.Gateway, t)
+	sendTime := time.Unix(1700000000, 0)
+
+	if err := e.AddSample(gatewayID, &pb.GatewayPollResponse{},
+		sendTime, time.Second); err == nil {
+		t.Errorf("No error for response without timestamp.")
+	}
+	if err := e.AddSample(gatewayID, &pb.GatewayPollResponse{
+		ReceivedTs: sendTime.UnixNano(), GatewayDelay: int64(2 * time.Second)},
+		sendTime, time.Second); err == nil {
+		t.Errorf("No error for gateway delay larger than round trip.")
+	}
+
+	for i := 0; i < 5; i++ {
+		addTestSample(t, e, gatewayID, sendTime, time.Duration(i)*time.Second,
+			10*time.Millisecond, 0)
+	}
+	if len(e.samples) != 3 {
+		t.Fatalf("Unexpected number of samples: %d", len(e.samples))
+	}
+	for _, s := range e.samples {
+		if s.offset < 2*time.Second {
+			t.Errorf("Old sample with offset %s not replaced.", s.offset)
+		}
+	}
+}
+
+// Tests that ClockOffsetEstimator.Apply only sets the offset used as a
+// netTime.TimeSource when there are enough samples and the bound is small
+// enough.
+func TestClockOffsetEstimator_Apply(t *testing.T) {
+	var _ netTime.TimeSource = &ClockOffsetEstimator{}
+	e := NewClockOffsetEstimator(DefaultClockOffsetParams())
+	gatewayID := id.NewIdFromString("gw", id.Gateway, t)
+
+	if _, err := e.Apply(time.Second); err == nil {
+		t.Errorf("No error without samples.")
+	}
+
+	sendTime := time.Now()
+	for i := 0; i < 3; i++ {
+		addTestSample(t, e, gatewayID, sendTime, time.Hour,
+			100*time.Millisecond, 0)
+	}
+	if _, err := e.Apply(time.Millisecond); err == nil {
+		t.Errorf("No error for bound exceeding maximum.")
+	}
+	if diff := time.Duration(e.NowMs())*time.Millisecond -
+		time.Duration(time.Now().UnixNano()); diff > time.Second {
+		t.Errorf("Offset applied despite large bound: %s", diff)
+	}
+
+	if _, err := e.Apply(time.Second); err != nil {
+		t.Fatalf("Apply error: %+v", err)
+	}
+	if diff := time.Duration(e.NowMs())*time.Millisecond -
+		time.Duration(time.Now().UnixNano()); diff < 59*time.Minute {
+		t.Errorf("Offset not applied: %s", diff)
+	}
+}
+
+// Tests that an estimator with unset parameters returns an error rather than
+// panicking without samples and estimates from a single sample.
+func TestNewClockOffsetEstimator_ZeroParams(t *testing.T) {
+	e := NewClockOffsetEstimator(ClockOffsetParams{})
+	if _, err := e.Estimate(); err == nil {
+		t.Errorf("No error without samples.")
+	}
+
+	addTestSample(t, e, id.NewIdFromString("gw", id.Gateway, t), time.Now(),
+		time.Minute, 100*time.Millisecond, 0)
+	estimate, err := e.Estimate()
+	if err != nil {
+		t.Fatalf("Estimate error: %+v", err)
+	}
+	if estimate.NumSamples != 1 || estimate.Offset < 59*time.Second {
+		t.Errorf("Unexpected estimate: %+v", estimate)
+	}
+}
@@ -0,0 +1,109 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the handling of caller contexts passed to the send functions
+
+package client
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/comms/connect"
+	"time"
+)
+
+// contextDoneErr is returned from a send attempt when the caller's context is
+// done before it starts. It deliberately does not read as a connection error,
+// so that connect does not disconnect the host and retry.
+const contextDoneErr = "context done before sending to %s"
+
+// getMessagingContext returns the context for a single send attempt to the
+// host. It is cancelled with the parent and expires at the parent's deadline
+// or once the host's send timeout has passed, whichever comes first.
+func getMessagingContext(parent context.Context, host *connect.Host) (
+	context.Context, context.CancelFunc) {
+	hostCtx, hostCancel := host.GetMessagingContext()
+	deadline, ok := hostCtx.Deadline()
+	hostCancel()
+
+	if !ok {
+		return context.WithCancel(parent)
+	}
+	return context.WithDeadline(parent, deadline)
+}
+
+// getStreamingContext returns the context for a stream. It is cancelled with
+// the parent and, if the parent has no deadline, expires after the default
+// timeout.
+func getStreamingContext(parent context.Context,
+	defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := parent.Deadline(); ok {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, defaultTimeout)
+}
+
+// sendWithContext executes the send function on the host, retrying it as
+// described by the policy and skipping any attempt started after the context
+// is done. The send function is passed the context of the current attempt. If
+// the context is done when the send fails, the context's error is returned so
+// that callers can match it with errors.Is.
+func (c *Comms) sendWithContext(ctx context.Context, host *connect.Host,
+	policy RetryPolicy, f func(ctx context.Context,
+		conn connect.Connection) (*any.Any, error)) (*any.Any, error) {
+	var result *any.Any
+	err := policy.do(ctx, "send to "+host.GetId().String(),
+		func(ctx context.Context) error {
+			if err := ctx.Err(); err != nil {
+				return errors.WithStack(err)
+			}
+
+			var err error
+			result, err = c.Send(host,
+				func(conn connect.Connection) (*any.Any, error) {
+					if ctx.Err() != nil {
+						return nil, errors.Errorf(contextDoneErr, host.GetId())
+					}
+					return f(ctx, conn)
+				})
+
+			return contextError(ctx, host, err)
+		})
+
+	return result, err
+}
+
+// streamWithContext executes the stream function on the host, skipping any
+// attempt started after the context is done, in the same way as
+// sendWithContext.
+func (c *Comms) streamWithContext(ctx context.Context, host *connect.Host,
+	f func(conn connect.Connection) (interface{}, error)) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result, err := c.Stream(host, func(conn connect.Connection) (interface{}, error) {
+		if ctx.Err() != nil {
+			return nil, errors.Errorf(contextDoneErr, host.GetId())
+		}
+		return f(conn)
+	})
+
+	return result, contextError(ctx, host, err)
+}
+
+// contextError returns the context's error, annotated with the error of the
+// send, if the send failed once the context was done. Otherwise, the error of
+// the send is returned unchanged.
+func contextError(ctx context.Context, host *connect.Host, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	return errors.WithMessagef(ctx.Err(), "failed to send to %s: %v",
+		host.GetId(), err)
+}
@@ -0,0 +1,151 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/gateway"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"testing"
+	"time"
+)
+
+// startContextTestGateway starts a gateway with the RequestMessages handler
+// and returns a client and its host for the gateway.
+func startContextTestGateway(t *testing.T,
+	handler func(msg *pb.GetMessages) (*pb.GetMessagesResponse, error)) (
+	*Comms, *connect.Host) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	impl := gateway.NewImplementation()
+	impl.Functions.RequestMessages = handler
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	t.Cleanup(gw.Shutdown)
+
+	pk := testkeys.LoadFromPath(testkeys.GetGatewayKeyPath())
+	c, err := NewClientComms(testID, nil, pk, nil)
+	if err != nil {
+		t.Fatalf("Could not start client: %v", err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := c.Manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	return c, host
+}
+
+// Tests that a send with a cancelled context returns the context's error
+// without reaching the gateway.
+func TestComms_RequestMessagesWithContext_Cancelled(t *testing.T) {
+	numRequests := 0
+	c, host := startContextTestGateway(t,
+		func(*pb.GetMessages) (*pb.GetMessagesResponse, error) {
+			numRequests++
+			return &pb.GetMessagesResponse{}, nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.RequestMessagesWithContext(ctx, host, &pb.GetMessages{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, received: %+v", err)
+	}
+	if numRequests != 0 {
+		t.Errorf("Request reached the gateway %d times", numRequests)
+	}
+}
+
+// Tests that the context's deadline is passed through to the request.
+func TestComms_RequestMessagesWithContext_Deadline(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c, host := startContextTestGateway(t,
+		func(*pb.GetMessages) (*pb.GetMessagesResponse, error) {
+			<-release
+			return &pb.GetMessagesResponse{}, nil
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.RequestMessagesWithContext(ctx, host, &pb.GetMessages{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, received: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Request took %s, longer than the context allows", elapsed)
+	}
+}
+
+// Tests that getMessagingContext expires with the earlier of the parent's
+// deadline and the host's send timeout.
+func Test_getMessagingContext(t *testing.T) {
+	params := connect.GetDefaultHostParams()
+	params.SendTimeout = time.Minute
+	host, err := connect.NewHost(id.NewIdFromString("test", id.Gateway, t),
+		"0.0.0.0:0", nil, params)
+	if err != nil {
+		t.Fatalf("Failed to create host: %+v", err)
+	}
+
+	ctx, cancel := getMessagingContext(context.Background(), host)
+	deadline, ok := ctx.Deadline()
+	cancel()
+	if !ok || time.Until(deadline) < 50*time.Second {
+		t.Errorf("Expected the host's send timeout, received %s",
+			time.Until(deadline))
+	}
+
+	parent, parentCancel := context.WithTimeout(context.Background(), time.Second)
+	defer parentCancel()
+	ctx, cancel = getMessagingContext(parent, host)
+	defer cancel()
+	parentDeadline, _ := parent.Deadline()
+	if deadline, _ = ctx.Deadline(); !deadline.Equal(parentDeadline) {
+		t.Errorf("Expected the parent's deadline %s, received %s",
+			parentDeadline, deadline)
+	}
+
+	parentCancel()
+	if !errors.Is(ctx.Err(), context.Canceled) {
+		t.Errorf("Context not cancelled with parent: %v", ctx.Err())
+	}
+}
+
+// Tests that getStreamingContext keeps the parent's deadline and only applies
+// the default timeout when the parent has none.
+func Test_getStreamingContext(t *testing.T) {
+	ctx, cancel := getStreamingContext(context.Background(), time.Minute)
+	deadline, ok := ctx.Deadline()
+	cancel()
+	if !ok || time.Until(deadline) < 50*time.Second {
+		t.Errorf("Expected the default timeout, received %s",
+			time.Until(deadline))
+	}
+
+	parent, parentCancel := context.WithTimeout(context.Background(), time.Hour)
+	defer parentCancel()
+	ctx, cancel = getStreamingContext(parent, time.Minute)
+	defer cancel()
+	parentDeadline, _ := parent.Deadline()
+	if deadline, _ = ctx.Deadline(); !deadline.Equal(parentDeadline) {
+		t.Errorf("Expected the parent's deadline %s, received %s",
+			parentDeadline, deadline)
+	}
+}
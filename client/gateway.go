@@ -10,6 +10,8 @@
 package client
 
 import (
+	"context"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/pkg/errors"
@@ -18,18 +20,35 @@ import (
 	"gitlab.com/xx_network/comms/connect"
 	"google.golang.org/grpc"
 	"io"
-	"strconv"
 	"time"
 )
 
+// messageStreamTimeout is the time allowed for a streamed message request to
+// complete when the caller's context has no deadline.
+const messageStreamTimeout = 30 * time.Second
+
+// pollTimeout is the time allowed for a poll to complete when the caller's
+// context has no deadline. It ensures that streaming does not block the
+// follower.
+const pollTimeout = 10 * time.Second
+
 // SendPutMessage Client -> Gateway Send Function
 func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 	timeout time.Duration) (*pb.GatewaySlotResponse, error) {
+	return c.SendPutMessageWithContext(context.Background(),
+		host, message, timeout)
+}
+
+// SendPutMessageWithContext is SendPutMessage with a context that can cancel
+// the send and set its deadline.
+func (c *Comms) SendPutMessageWithContext(ctx context.Context,
+	host *connect.Host, message *pb.GatewaySlot,
+	timeout time.Duration) (*pb.GatewaySlotResponse, error) {
 
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContextWithTimeout(timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
 		// Send the message
@@ -52,9 +71,9 @@ func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Put message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().Put, f)
 	if err != nil {
-		return nil, err
+		return nil, checkRateLimited(err)
 	}
 
 	result := &pb.GatewaySlotResponse{}
@@ -62,14 +81,25 @@ func (c *Comms) SendPutMessage(host *connect.Host, message *pb.GatewaySlot,
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// SendPutManyMessages Client -> Gateway Send Function
+// SendPutManyMessages Client -> Gateway Send Function. The response contains
+// the result of each slot if the gateway reports them; use
+// pb.GatewaySlots.Rejected to get the slots that need to be resent.
 func (c *Comms) SendPutManyMessages(host *connect.Host,
 	messages *pb.GatewaySlots, timeout time.Duration) (
 	*pb.GatewaySlotResponse, error) {
+	return c.SendPutManyMessagesWithContext(context.Background(),
+		host, messages, timeout)
+}
+
+// SendPutManyMessagesWithContext is SendPutManyMessages with a context that can
+// cancel the send and set its deadline.
+func (c *Comms) SendPutManyMessagesWithContext(ctx context.Context,
+	host *connect.Host, messages *pb.GatewaySlots, timeout time.Duration) (
+	*pb.GatewaySlotResponse, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContextWithTimeout(timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
 		// Send the message
@@ -91,24 +121,37 @@ func (c *Comms) SendPutManyMessages(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending PutManyMessages: %+v", messages)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().Put, f)
 	if err != nil {
-		return nil, err
+		return nil, checkRateLimited(err)
 	}
 
 	result := &pb.GatewaySlotResponse{}
+	if err = ptypes.UnmarshalAny(resultMsg, result); err != nil {
+		return nil, err
+	}
 
-	return result, ptypes.UnmarshalAny(resultMsg, result)
+	// Ensure each slot has a result, if any are reported
+	return result, result.CheckSlotResults(len(messages.GetMessages()))
 }
 
 // SendRequestClientKeyMessage Client -> Gateway Send Function
 func (c *Comms) SendRequestClientKeyMessage(host *connect.Host,
 	message *pb.SignedClientKeyRequest) (*pb.SignedKeyResponse, error) {
+	return c.SendRequestClientKeyMessageWithContext(context.Background(),
+		host, message)
+}
+
+// SendRequestClientKeyMessageWithContext is SendRequestClientKeyMessage with a
+// context that can cancel the send and set its deadline.
+func (c *Comms) SendRequestClientKeyMessageWithContext(ctx context.Context,
+	host *connect.Host,
+	message *pb.SignedClientKeyRequest) (*pb.SignedKeyResponse, error) {
 
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -132,7 +175,8 @@ func (c *Comms) SendRequestClientKeyMessage(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Request Client Key message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Registration, f)
 	if err != nil {
 		return nil, err
 	}
@@ -145,11 +189,19 @@ func (c *Comms) SendRequestClientKeyMessage(host *connect.Host,
 // Client -> Gateway Send Function
 func (c *Comms) BatchNodeRegistration(host *connect.Host,
 	message *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error) {
+	return c.BatchNodeRegistrationWithContext(context.Background(), host, message)
+}
+
+// BatchNodeRegistrationWithContext is BatchNodeRegistration with a context that
+// can cancel the send and set its deadline.
+func (c *Comms) BatchNodeRegistrationWithContext(ctx context.Context,
+	host *connect.Host,
+	message *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error) {
 
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -173,7 +225,8 @@ func (c *Comms) BatchNodeRegistration(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Request Client Key message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Registration, f)
 	if err != nil {
 		return nil, err
 	}
@@ -187,17 +240,26 @@ func (c *Comms) BatchNodeRegistration(host *connect.Host,
 // Returns a time.Time of the local clock (not netTime) when the comm was sent
 // and a time.Duration representing the roundTripTime of the comm
 func (c *Comms) SendPoll(host *connect.Host,
+	message *pb.GatewayPoll) (*pb.GatewayPollResponse, time.Time, time.Duration, error) {
+	return c.SendPollWithContext(context.Background(), host, message)
+}
+
+// SendPollWithContext is SendPoll with a context that can cancel the poll and
+// set its deadline. If the context has no deadline, the poll times out after
+// pollTimeout.
+func (c *Comms) SendPollWithContext(ctx context.Context, host *connect.Host,
 	message *pb.GatewayPoll) (*pb.GatewayPollResponse, time.Time, time.Duration, error) {
 	// Set up the context with a timeout to ensure that streaming does not
 	// block the follower
-	ctx, cancel := connect.StreamingContextWithTimeout(10 * time.Second)
+	ctx, cancel := getStreamingContext(ctx, pollTimeout)
 	defer cancel()
+	ctx = pb.AdvertiseChunkCompression(ctx)
 
 	var startTime time.Time
 
 	// Create the Stream Function
 	roundTripTime := time.Duration(0)
-	f := func(conn connect.Connection) (interface{}, error) {
+	f := func(ctx context.Context, conn connect.Connection) (interface{}, error) {
 		// Send the message
 		if conn.IsWeb() {
 			wc := conn.GetWebConn()
@@ -235,73 +297,32 @@ func (c *Comms) SendPoll(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Poll message: %+v", message)
-	resultClient, err := c.Stream(host, f)
-	if err != nil {
-		return nil, time.Time{}, 0, err
-	}
-
-	stream := resultClient.(pb.Gateway_PollClient)
-	jww.DEBUG.Printf("Receiving chunks for gateway poll from %s", host.GetId().String())
-	closeErr := stream.CloseSend()
-	if closeErr != nil {
-		return nil, time.Time{}, 0, wrapError(closeErr, "Unable to close send stream")
-	}
-
-	// Get the total number of chunks from the header
-	md, err := stream.Header()
-	if err != nil {
-		closeErr = stream.RecvMsg(nil)
-		return nil, time.Time{}, 0, wrapError(closeErr, "Could not "+
-			"receive streaming header from %s: %s", host.GetId(), err)
-	}
-
-	// Check if metadata has the expected header
-	chunkHeader := md.Get(pb.ChunkHeader)
-	if len(chunkHeader) == 0 {
-		closeErr = stream.RecvMsg(nil)
-		return nil, time.Time{}, 0, wrapError(closeErr, pb.NoStreamingHeaderErr, host.GetId())
-	}
-
-	// Process header
-	totalChunks, err := strconv.Atoi(chunkHeader[0])
+	result := &pb.GatewayPollResponse{}
+	err := c.receiveChunkedResponse(ctx, host,
+		c.GetRetryPolicies().Poll, f, "gateway poll", result)
 	if err != nil {
-		closeErr = stream.RecvMsg(nil)
-		return nil, time.Time{}, 0, wrapError(closeErr, "Invalid header received: %v", err)
-	}
-
-	// Receive the chunks
-	chunks := make([]*pb.StreamChunk, 0, totalChunks)
-	chunk, err := stream.Recv()
-	receivedChunks := 0
-	for ; err == nil && receivedChunks <= totalChunks; chunk, err = stream.Recv() {
-		chunks = append(chunks, chunk)
-		receivedChunks++
-	}
-	if err != io.EOF { // EOF is an expected error after server-side has completed streaming
-		return nil, time.Time{}, 0, errors.Errorf("Failed to "+
-			"complete streaming, received %d of %d messages: %s",
-			receivedChunks, totalChunks, err)
+		return nil, time.Time{}, 0, checkRateLimited(err)
 	}
 
-	// Close stream once done
-	closeErr = stream.RecvMsg(nil)
-	if closeErr != io.EOF {
-		return nil, time.Time{}, 0, errors.WithMessagef(closeErr, "Received error on "+
-			"closing stream with %s", host.GetId())
-	}
-
-	// Assemble the result
-	result := &pb.GatewayPollResponse{}
-	return result, startTime, roundTripTime, pb.AssembleChunksIntoResponse(chunks, result)
+	return result, startTime, roundTripTime, nil
 }
 
 // RequestHistoricalRounds Client -> Gateway Send Function
 func (c *Comms) RequestHistoricalRounds(host *connect.Host,
+	message *pb.HistoricalRounds) (*pb.HistoricalRoundsResponse, error) {
+	return c.RequestHistoricalRoundsWithContext(context.Background(),
+		host, message)
+}
+
+// RequestHistoricalRoundsWithContext is RequestHistoricalRounds with a context
+// that can cancel the send and set its deadline.
+func (c *Comms) RequestHistoricalRoundsWithContext(ctx context.Context,
+	host *connect.Host,
 	message *pb.HistoricalRounds) (*pb.HistoricalRoundsResponse, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -323,7 +344,8 @@ func (c *Comms) RequestHistoricalRounds(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Requesting Historical Rounds: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Messages, f)
 	if err != nil {
 		return nil, err
 	}
@@ -335,11 +357,19 @@ func (c *Comms) RequestHistoricalRounds(host *connect.Host,
 
 // RequestMessages Client -> Gateway Send Function
 func (c *Comms) RequestMessages(host *connect.Host,
+	message *pb.GetMessages) (*pb.GetMessagesResponse, error) {
+	return c.RequestMessagesWithContext(context.Background(), host, message)
+}
+
+// RequestMessagesWithContext is RequestMessages with a context that can cancel
+// the send and set its deadline.
+func (c *Comms) RequestMessagesWithContext(ctx context.Context,
+	host *connect.Host,
 	message *pb.GetMessages) (*pb.GetMessagesResponse, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		var resultMsg = &pb.GetMessagesResponse{}
@@ -361,9 +391,10 @@ func (c *Comms) RequestMessages(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Requesing Messages: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Messages, f)
 	if err != nil {
-		return nil, err
+		return nil, checkRateLimited(err)
 	}
 
 	// Marshall the result
@@ -373,11 +404,19 @@ func (c *Comms) RequestMessages(host *connect.Host,
 
 // RequestMessages Client -> Gateway Send Function
 func (c *Comms) RequestBatchMessages(host *connect.Host,
+	message *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
+	return c.RequestBatchMessagesWithContext(context.Background(), host, message)
+}
+
+// RequestBatchMessagesWithContext is RequestBatchMessages with a context that
+// can cancel the send and set its deadline.
+func (c *Comms) RequestBatchMessagesWithContext(ctx context.Context,
+	host *connect.Host,
 	message *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		var resultMsg = &pb.GetMessagesResponseBatch{}
@@ -399,7 +438,8 @@ func (c *Comms) RequestBatchMessages(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Requesting batch of Messages: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Messages, f)
 	if err != nil {
 		return nil, err
 	}
@@ -409,12 +449,105 @@ func (c *Comms) RequestBatchMessages(host *connect.Host,
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
+// RequestMessagesStream Client -> Gateway Send Function. Behaves the same as
+// RequestMessages, but the response is streamed in chunks so that it is not
+// limited by the maximum message size.
+func (c *Comms) RequestMessagesStream(host *connect.Host,
+	message *pb.GetMessages) (*pb.GetMessagesResponse, error) {
+	return c.RequestMessagesStreamWithContext(context.Background(), host, message)
+}
+
+// RequestMessagesStreamWithContext is RequestMessagesStream with a context that
+// can cancel the request and set its deadline. If the context has no deadline,
+// the request times out after messageStreamTimeout.
+func (c *Comms) RequestMessagesStreamWithContext(ctx context.Context,
+	host *connect.Host, message *pb.GetMessages) (*pb.GetMessagesResponse, error) {
+	// Set up the context with a timeout to ensure that streaming does not
+	// block the caller
+	ctx, cancel := getStreamingContext(ctx, messageStreamTimeout)
+	defer cancel()
+	ctx = pb.AdvertiseChunkCompression(ctx)
+
+	// Create the Stream Function
+	f := func(ctx context.Context, conn connect.Connection) (interface{}, error) {
+		if conn.IsWeb() {
+			return newWebChunkStream(ctx, conn,
+				"/mixmessages.Gateway/RequestMessagesStream", message)
+		}
+		return pb.NewGatewayClient(conn.GetGrpcConn()).
+			RequestMessagesStream(ctx, message)
+	}
+
+	// Execute the Stream function
+	jww.TRACE.Printf("Requesting Messages by stream: %+v", message)
+	result := &pb.GetMessagesResponse{}
+	err := c.receiveChunkedResponse(ctx, host,
+		c.GetRetryPolicies().Messages, f, "message request", result)
+	if err != nil {
+		return nil, checkRateLimited(err)
+	}
+
+	return result, nil
+}
+
+// RequestBatchMessagesStream Client -> Gateway Send Function. Behaves the
+// same as RequestBatchMessages, but the response is streamed in chunks so that
+// it is not limited by the maximum message size.
+func (c *Comms) RequestBatchMessagesStream(host *connect.Host,
+	message *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
+	return c.RequestBatchMessagesStreamWithContext(context.Background(),
+		host, message)
+}
+
+// RequestBatchMessagesStreamWithContext is RequestBatchMessagesStream with a
+// context that can cancel the request and set its deadline. If the context has
+// no deadline, the request times out after messageStreamTimeout.
+func (c *Comms) RequestBatchMessagesStreamWithContext(ctx context.Context,
+	host *connect.Host, message *pb.GetMessagesBatch) (
+	*pb.GetMessagesResponseBatch, error) {
+	// Set up the context with a timeout to ensure that streaming does not
+	// block the caller
+	ctx, cancel := getStreamingContext(ctx, messageStreamTimeout)
+	defer cancel()
+	ctx = pb.AdvertiseChunkCompression(ctx)
+
+	// Create the Stream Function
+	f := func(ctx context.Context, conn connect.Connection) (interface{}, error) {
+		if conn.IsWeb() {
+			return newWebChunkStream(ctx, conn,
+				"/mixmessages.Gateway/RequestBatchMessagesStream", message)
+		}
+		return pb.NewGatewayClient(conn.GetGrpcConn()).
+			RequestBatchMessagesStream(ctx, message)
+	}
+
+	// Execute the Stream function
+	jww.TRACE.Printf("Requesting batch of Messages by stream: %+v", message)
+	result := &pb.GetMessagesResponseBatch{}
+	err := c.receiveChunkedResponse(ctx, host,
+		c.GetRetryPolicies().Messages, f, "batch message request", result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // GetGatewayTLSCertificate Client -> Gateway cert request
 func (c *Comms) GetGatewayTLSCertificate(host *connect.Host,
 	message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error) {
-	f := func(conn connect.Connection) (*any.Any, error) {
+	return c.GetGatewayTLSCertificateWithContext(context.Background(),
+		host, message)
+}
+
+// GetGatewayTLSCertificateWithContext is GetGatewayTLSCertificate with a
+// context that can cancel the send and set its deadline.
+func (c *Comms) GetGatewayTLSCertificateWithContext(ctx context.Context,
+	host *connect.Host,
+	message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		var resultMsg = &pb.GatewayCertificate{}
@@ -436,7 +569,8 @@ func (c *Comms) GetGatewayTLSCertificate(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Requesing TLS certificate from gateway: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Registration, f)
 	if err != nil {
 		return nil, err
 	}
@@ -446,6 +580,102 @@ func (c *Comms) GetGatewayTLSCertificate(host *connect.Host,
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
+// receiveChunkedResponse opens the stream returned by the stream function,
+// receives the response streamed by the gateway in chunks, as announced in the
+// ChunkHeader, and assembles it into the result. Failed attempts are retried
+// as described by the policy, each on a new stream. The stream function is
+// passed the context of the current attempt. The description is used in log
+// messages.
+func (c *Comms) receiveChunkedResponse(ctx context.Context, host *connect.Host,
+	policy RetryPolicy, f func(ctx context.Context,
+		conn connect.Connection) (interface{}, error), description string,
+	result proto.Message) error {
+	return policy.do(ctx, description, func(ctx context.Context) error {
+		// Cancel the stream of the attempt once it is done with
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		return c.receiveChunks(ctx, host,
+			func(conn connect.Connection) (interface{}, error) {
+				return f(ctx, conn)
+			}, description, result)
+	})
+}
+
+// chunkStreamClient is the client of a stream that returns its response in
+// chunks, such as the RequestMessagesStream and RequestBatchMessagesStream
+// streams.
+type chunkStreamClient interface {
+	Recv() (*pb.StreamChunk, error)
+	grpc.ClientStream
+}
+
+// receiveChunks makes a single attempt of receiveChunkedResponse.
+func (c *Comms) receiveChunks(ctx context.Context, host *connect.Host,
+	f func(conn connect.Connection) (interface{}, error), description string,
+	result proto.Message) error {
+	resultClient, err := c.streamWithContext(ctx, host, f)
+	if err != nil {
+		return err
+	}
+
+	stream := resultClient.(chunkStreamClient)
+	jww.DEBUG.Printf("Receiving chunks for %s from %s", description, host.GetId().String())
+	closeErr := stream.CloseSend()
+	if closeErr != nil {
+		return wrapError(closeErr, "Unable to close send stream")
+	}
+
+	// Get the total number of chunks from the header
+	md, err := stream.Header()
+	if err != nil {
+		closeErr = stream.RecvMsg(nil)
+		return wrapError(closeErr, "Could not "+
+			"receive streaming header from %s: %s", host.GetId(), err)
+	}
+
+	// Check if metadata has the expected header
+	chunkHeader := md.Get(pb.ChunkHeader)
+	if len(chunkHeader) == 0 {
+		closeErr = stream.RecvMsg(nil)
+		return wrapError(closeErr, pb.NoStreamingHeaderErr, host.GetId())
+	}
+
+	// Receive and verify the chunks
+	chunks, err := pb.ReceiveChunks(md, stream.Recv)
+	if err != nil {
+		return errors.WithMessagef(err, "Failed to receive %s from %s",
+			description, host.GetId())
+	}
+
+	// Close stream once done
+	closeErr = stream.RecvMsg(nil)
+	if closeErr != io.EOF {
+		return errors.WithMessagef(closeErr, "Received error on "+
+			"closing stream with %s", host.GetId())
+	}
+
+	// Assemble the result, decompressing it if the gateway compressed it
+	return pb.AssembleCompressedChunksIntoResponse(
+		chunks, result, pb.GetChunkCompression(md))
+}
+
+// newWebChunkStream opens a server stream on the web connection for a method
+// that streams its response in chunks and sends the message.
+func newWebChunkStream(ctx context.Context, conn connect.Connection,
+	method string, message proto.Message) (chunkStreamClient, error) {
+	clientStream, err := conn.GetWebConn().NewServerStream(
+		&grpc.StreamDesc{ServerStreams: true}, method)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = clientStream.Send(ctx, message); err != nil {
+		return nil, err
+	}
+	return newServerStream(ctx, clientStream), nil
+}
+
 func wrapError(err error, s string, i ...interface{}) error {
 	if err == nil {
 		return errors.Errorf(s, i...)
@@ -0,0 +1,364 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains a gateway selector that picks gateway hosts by observed latency
+// and errors
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/network"
+	ds "gitlab.com/elixxir/comms/network/dataStructures"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"sync"
+	"time"
+)
+
+// Error messages.
+const (
+	noGatewaysErr         = "no gateways available to the selector"
+	allGatewaysCoolOffErr = "all %d gateways are cooling off"
+	noSelectorNdfErr      = "network instance has no NDF"
+	hardCodedGatewayIDErr = "gateway ID %s collides with a hard coded ID"
+)
+
+// GatewaySelectorParams contains the parameters of a GatewaySelector.
+type GatewaySelectorParams struct {
+	// Weight of the newest sample in the rolling latency average, in (0, 1]
+	LatencySmoothing float64
+
+	// Latency assumed for a gateway that has not yet been sampled. Keeping it
+	// low ensures that new gateways are tried.
+	InitialLatency time.Duration
+
+	// Latency added to a gateway's score for each unit of its error score.
+	// The error score increases by one on each error and halves on each
+	// success.
+	ErrorPenalty time.Duration
+
+	// Number of consecutive errors after which a gateway cools off
+	CoolOffThreshold int
+
+	// How long a gateway is skipped once it cools off
+	CoolOffDuration time.Duration
+
+	// Host parameters used for gateways added from the NDF that do not yet
+	// have a host
+	HostParams connect.HostParams
+}
+
+// DefaultGatewaySelectorParams returns the default GatewaySelectorParams.
+func DefaultGatewaySelectorParams() GatewaySelectorParams {
+	hostParams := connect.GetDefaultHostParams()
+	hostParams.MaxRetries = 3
+	hostParams.AuthEnabled = false
+
+	return GatewaySelectorParams{
+		LatencySmoothing: 0.2,
+		InitialLatency:   100 * time.Millisecond,
+		ErrorPenalty:     time.Second,
+		CoolOffThreshold: 3,
+		CoolOffDuration:  30 * time.Second,
+		HostParams:       hostParams,
+	}
+}
+
+// GatewaySelector keeps a rolling latency and error score for each gateway
+// host, based on the outcome of the requests made through it, and selects the
+// best scoring host for each request. Hosts that fail repeatedly, or that rate
+// limit the client, cool off and are skipped until the cool off expires.
+type GatewaySelector struct {
+	comms   *Comms
+	params  GatewaySelectorParams
+	scores  map[id.ID]*gatewayScore
+	ndfHash []byte
+	mux     sync.Mutex
+}
+
+// gatewayScore tracks the outcomes of requests to a single gateway.
+type gatewayScore struct {
+	host              *connect.Host
+	latency           time.Duration
+	sampled           bool
+	errorScore        float64
+	consecutiveErrors int
+	coolOffUntil      time.Time
+}
+
+// score returns the score of the gateway. Lower is better.
+func (s *gatewayScore) score(params GatewaySelectorParams) time.Duration {
+	latency := s.latency
+	if !s.sampled {
+		latency = params.InitialLatency
+	}
+	return latency + time.Duration(s.errorScore*float64(params.ErrorPenalty))
+}
+
+// NewGatewaySelector returns a GatewaySelector with no hosts. Hosts are added
+// with AddHost or from the NDF with UpdateHosts and SyncNetwork.
+func (c *Comms) NewGatewaySelector(params GatewaySelectorParams) *GatewaySelector {
+	return &GatewaySelector{
+		comms:  c,
+		params: params,
+		scores: make(map[id.ID]*gatewayScore),
+	}
+}
+
+// AddHost adds the gateway host to the selector. Adding a host that is already
+// present keeps its score.
+func (gs *GatewaySelector) AddHost(host *connect.Host) {
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+
+	if s, exists := gs.scores[*host.GetId()]; exists {
+		s.host = host
+		return
+	}
+	gs.scores[*host.GetId()] = &gatewayScore{host: host}
+}
+
+// RemoveHost removes the gateway from the selector.
+func (gs *GatewaySelector) RemoveHost(gatewayID *id.ID) {
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+	delete(gs.scores, *gatewayID)
+}
+
+// UpdateHosts makes the selector's hosts match the gateways in the NDF. New
+// gateways are added, using the existing host in the comms manager if there is
+// one, and gateways no longer in the NDF are removed. As with the hosts of a
+// network.Instance, gateway addresses are replaced by their override in the
+// list, which may be nil, and gateways with a hard coded ID are rejected.
+func (gs *GatewaySelector) UpdateHosts(def *ndf.NetworkDefinition,
+	overrides *ds.IpOverrideList) error {
+	inNdf := make(map[id.ID]struct{}, len(def.Gateways))
+	for i, gateway := range def.Gateways {
+		gatewayID, err := id.Unmarshal(def.Nodes[i].ID)
+		if err != nil {
+			return errors.WithMessagef(err, "invalid ID for node %d in NDF", i)
+		}
+		gatewayID.SetType(id.Gateway)
+		inNdf[*gatewayID] = struct{}{}
+
+		addr := gateway.Address
+		if overrides != nil {
+			addr = overrides.CheckOverride(gatewayID, addr)
+		}
+
+		host, exists := gs.comms.GetHost(gatewayID)
+		if !exists {
+			if id.CollidesWithHardCodedID(gatewayID) {
+				return errors.Errorf(hardCodedGatewayIDErr, gatewayID)
+			}
+			host, err = gs.comms.AddHost(gatewayID, addr,
+				[]byte(gateway.TlsCertificate), gs.params.HostParams)
+			if err != nil {
+				return errors.WithMessagef(err,
+					"could not add gateway host %s", gatewayID)
+			}
+		} else if host.GetAddress() != addr {
+			host.UpdateAddress(addr)
+		}
+		gs.AddHost(host)
+	}
+
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+	for gatewayID := range gs.scores {
+		if _, exists := inNdf[gatewayID]; !exists {
+			delete(gs.scores, gatewayID)
+		}
+	}
+
+	return nil
+}
+
+// SyncNetwork updates the selector's hosts from the network instance's partial
+// NDF if it has changed since the last sync. It is intended to be called after
+// each NDF update, such as after each poll.
+func (gs *GatewaySelector) SyncNetwork(instance *network.Instance) error {
+	partial := instance.GetPartialNdf()
+	if partial == nil || partial.Get() == nil {
+		return errors.New(noSelectorNdfErr)
+	}
+
+	gs.mux.Lock()
+	unchanged := gs.ndfHash != nil && bytes.Equal(gs.ndfHash, partial.GetHash())
+	gs.mux.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	if err := gs.UpdateHosts(partial.Get(), instance.GetIpOverrideList()); err != nil {
+		return err
+	}
+
+	gs.mux.Lock()
+	gs.ndfHash = partial.GetHash()
+	gs.mux.Unlock()
+
+	return nil
+}
+
+// Select returns the host with the best score out of those not cooling off.
+func (gs *GatewaySelector) Select() (*connect.Host, error) {
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+
+	if len(gs.scores) == 0 {
+		return nil, errors.New(noGatewaysErr)
+	}
+
+	now := time.Now()
+	var best *gatewayScore
+	for _, s := range gs.scores {
+		if now.Before(s.coolOffUntil) {
+			continue
+		}
+		if best == nil || s.score(gs.params) < best.score(gs.params) {
+			best = s
+		}
+	}
+
+	if best == nil {
+		return nil, errors.Errorf(allGatewaysCoolOffErr, len(gs.scores))
+	}
+
+	return best.host, nil
+}
+
+// Record updates the gateway's score with the outcome of a request that took
+// the given latency. If the gateway rate limited the request, it cools off for
+// the time the gateway asked for.
+func (gs *GatewaySelector) Record(gatewayID *id.ID, latency time.Duration,
+	err error) {
+	gs.mux.Lock()
+	defer gs.mux.Unlock()
+
+	s, exists := gs.scores[*gatewayID]
+	if !exists {
+		return
+	}
+
+	if err == nil {
+		if s.sampled {
+			s.latency += time.Duration(gs.params.LatencySmoothing *
+				float64(latency-s.latency))
+		} else {
+			s.latency, s.sampled = latency, true
+		}
+		s.errorScore /= 2
+		s.consecutiveErrors = 0
+		return
+	}
+
+	s.errorScore++
+	s.consecutiveErrors++
+
+	var rateLimited *RateLimitedError
+	if errors.As(err, &rateLimited) && rateLimited.RetryAfter > 0 {
+		s.coolOffUntil = time.Now().Add(rateLimited.RetryAfter)
+	} else if s.consecutiveErrors >= gs.params.CoolOffThreshold {
+		s.coolOffUntil = time.Now().Add(gs.params.CoolOffDuration)
+		s.consecutiveErrors = 0
+		jww.DEBUG.Printf("Gateway %s cooling off for %s after %d errors",
+			gatewayID, gs.params.CoolOffDuration, gs.params.CoolOffThreshold)
+	}
+}
+
+// record records the outcome of a request made with the context, unless the
+// request failed because the context was done, which is no fault of the
+// gateway.
+func (gs *GatewaySelector) record(ctx context.Context, gatewayID *id.ID,
+	latency time.Duration, err error) {
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+	gs.Record(gatewayID, latency, err)
+}
+
+// SendPoll sends the poll to the best gateway and records the outcome. The
+// host polled is returned along with the results of Comms.SendPoll.
+func (gs *GatewaySelector) SendPoll(message *pb.GatewayPoll) (
+	*pb.GatewayPollResponse, time.Time, time.Duration, *connect.Host, error) {
+	return gs.SendPollWithContext(context.Background(), message)
+}
+
+// SendPollWithContext is SendPoll with a context that can cancel the poll and
+// set its deadline. A poll aborted by the context is not held against the
+// gateway.
+func (gs *GatewaySelector) SendPollWithContext(ctx context.Context,
+	message *pb.GatewayPoll) (*pb.GatewayPollResponse, time.Time,
+	time.Duration, *connect.Host, error) {
+	host, err := gs.Select()
+	if err != nil {
+		return nil, time.Time{}, 0, nil, err
+	}
+
+	start := time.Now()
+	response, sendTime, rtt, err := gs.comms.SendPollWithContext(ctx, host, message)
+	if err != nil {
+		rtt = time.Since(start)
+	}
+	gs.record(ctx, host.GetId(), rtt, err)
+
+	return response, sendTime, rtt, host, err
+}
+
+// SendPutMessage sends the message to the best gateway and records the
+// outcome. The host the message was sent to is returned.
+func (gs *GatewaySelector) SendPutMessage(message *pb.GatewaySlot,
+	timeout time.Duration) (*pb.GatewaySlotResponse, *connect.Host, error) {
+	return gs.SendPutMessageWithContext(context.Background(), message, timeout)
+}
+
+// SendPutMessageWithContext is SendPutMessage with a context that can cancel
+// the send and set its deadline.
+func (gs *GatewaySelector) SendPutMessageWithContext(ctx context.Context,
+	message *pb.GatewaySlot, timeout time.Duration) (*pb.GatewaySlotResponse,
+	*connect.Host, error) {
+	host, err := gs.Select()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start := time.Now()
+	response, err := gs.comms.SendPutMessageWithContext(ctx, host, message, timeout)
+	gs.record(ctx, host.GetId(), time.Since(start), err)
+
+	return response, host, err
+}
+
+// RequestMessages requests the messages from the best gateway and records the
+// outcome. The host the request was sent to is returned.
+func (gs *GatewaySelector) RequestMessages(message *pb.GetMessages) (
+	*pb.GetMessagesResponse, *connect.Host, error) {
+	return gs.RequestMessagesWithContext(context.Background(), message)
+}
+
+// RequestMessagesWithContext is RequestMessages with a context that can cancel
+// the request and set its deadline.
+func (gs *GatewaySelector) RequestMessagesWithContext(ctx context.Context,
+	message *pb.GetMessages) (*pb.GetMessagesResponse, *connect.Host, error) {
+	host, err := gs.Select()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start := time.Now()
+	response, err := gs.comms.RequestMessagesWithContext(ctx, host, message)
+	gs.record(ctx, host.GetId(), time.Since(start), err)
+
+	return response, host, err
+}
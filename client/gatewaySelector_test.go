@@ -0,0 +1,193 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/gateway"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	ds "gitlab.com/elixxir/comms/network/dataStructures"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/ndf"
+	"testing"
+	"time"
+)
+
+// newTestSelector returns a GatewaySelector with a host for each of the IDs.
+func newTestSelector(t *testing.T, gatewayIDs ...*id.ID) *GatewaySelector {
+	pk := testkeys.LoadFromPath(testkeys.GetGatewayKeyPath())
+	c, err := NewClientComms(id.NewIdFromString("client", id.User, t), nil, pk, nil)
+	if err != nil {
+		t.Fatalf("Could not start client: %v", err)
+	}
+
+	gs := c.NewGatewaySelector(DefaultGatewaySelectorParams())
+	params := connect.GetDefaultHostParams()
+	params.DisableAutoConnect = true
+	for _, gatewayID := range gatewayIDs {
+		host, err := c.Manager.AddHost(gatewayID, getNextAddress(), nil, params)
+		if err != nil {
+			t.Fatalf("Unable to call NewHost: %+v", err)
+		}
+		gs.AddHost(host)
+	}
+
+	return gs
+}
+
+// Tests that GatewaySelector.Select picks the host with the lowest latency and
+// tries hosts that have not yet been sampled.
+func TestGatewaySelector_Select(t *testing.T) {
+	fast := id.NewIdFromString("fast", id.Gateway, t)
+	slow := id.NewIdFromString("slow", id.Gateway, t)
+	gs := newTestSelector(t, fast, slow)
+
+	gs.Record(slow, 500*time.Millisecond, nil)
+	if host, err := gs.Select(); err != nil {
+		t.Fatalf("Select error: %+v", err)
+	} else if !host.GetId().Cmp(fast) {
+		t.Errorf("Unsampled host not selected: %s", host.GetId())
+	}
+
+	gs.Record(fast, time.Second, nil)
+	gs.Record(slow, 10*time.Millisecond, nil)
+	if host, err := gs.Select(); err != nil {
+		t.Fatalf("Select error: %+v", err)
+	} else if !host.GetId().Cmp(slow) {
+		t.Errorf("Host with lower latency not selected: %s", host.GetId())
+	}
+}
+
+// Tests that a host is skipped while cooling off after consecutive errors, and
+// that Select fails once every host is cooling off.
+func TestGatewaySelector_Select_CoolOff(t *testing.T) {
+	good := id.NewIdFromString("good", id.Gateway, t)
+	bad := id.NewIdFromString("bad", id.Gateway, t)
+	gs := newTestSelector(t, good, bad)
+
+	gs.Record(good, time.Second, nil)
+	gs.Record(bad, time.Millisecond, nil)
+	for i := 0; i < gs.params.CoolOffThreshold; i++ {
+		if !gs.scores[*bad].coolOffUntil.IsZero() {
+			t.Fatalf("Host cooling off after %d errors.", i)
+		}
+		gs.Record(bad, time.Millisecond, errors.New("failed"))
+	}
+
+	// Make the host cooling off the best scoring one
+	gs.scores[*bad].errorScore = 0
+	if host, err := gs.Select(); err != nil {
+		t.Fatalf("Select error: %+v", err)
+	} else if !host.GetId().Cmp(good) {
+		t.Errorf("Host cooling off was selected.")
+	}
+
+	gs.Record(good, time.Second, &RateLimitedError{RetryAfter: time.Minute})
+	if _, err := gs.Select(); err == nil {
+		t.Errorf("No error when all hosts are cooling off.")
+	}
+}
+
+// Tests that GatewaySelector.UpdateHosts adds the gateways in the NDF and
+// removes those that are no longer in it.
+func TestGatewaySelector_UpdateHosts(t *testing.T) {
+	old := id.NewIdFromString("old", id.Gateway, t)
+	gs := newTestSelector(t, old)
+
+	if err := gs.UpdateHosts(testutils.NDF, nil); err != nil {
+		t.Fatalf("UpdateHosts error: %+v", err)
+	}
+	if _, exists := gs.scores[*old]; exists {
+		t.Errorf("Gateway not in the NDF was not removed.")
+	}
+
+	def := &ndf.NetworkDefinition{
+		Gateways: testutils.NDF.Gateways[:2], Nodes: testutils.NDF.Nodes[:2]}
+	if err := gs.UpdateHosts(def, nil); err != nil {
+		t.Fatalf("UpdateHosts error: %+v", err)
+	}
+	if len(gs.scores) != 2 {
+		t.Fatalf("Unexpected number of hosts: %d", len(gs.scores))
+	}
+	for i := range def.Nodes {
+		gatewayID, _ := id.Unmarshal(def.Nodes[i].ID)
+		gatewayID.SetType(id.Gateway)
+		if _, exists := gs.scores[*gatewayID]; !exists {
+			t.Errorf("Gateway %s in the NDF was not added.", gatewayID)
+		}
+	}
+}
+
+// Tests that GatewaySelector.UpdateHosts applies IP overrides to new and
+// existing hosts and rejects gateways with a hard coded ID.
+func TestGatewaySelector_UpdateHosts_Checks(t *testing.T) {
+	gs := newTestSelector(t)
+	def := &ndf.NetworkDefinition{
+		Gateways: testutils.NDF.Gateways[:1], Nodes: testutils.NDF.Nodes[:1]}
+	gatewayID, _ := id.Unmarshal(def.Nodes[0].ID)
+	gatewayID.SetType(id.Gateway)
+
+	overrides := ds.NewIpOverrideList()
+	overrides.Override(gatewayID, "10.0.0.1:8443")
+	if err := gs.UpdateHosts(def, overrides); err != nil {
+		t.Fatalf("UpdateHosts error: %+v", err)
+	}
+	if addr := gs.scores[*gatewayID].host.GetAddress(); addr != "10.0.0.1:8443" {
+		t.Errorf("Override not applied to new host: %s", addr)
+	}
+
+	overrides.Override(gatewayID, "10.0.0.2:8443")
+	if err := gs.UpdateHosts(def, overrides); err != nil {
+		t.Fatalf("UpdateHosts error: %+v", err)
+	}
+	if addr := gs.scores[*gatewayID].host.GetAddress(); addr != "10.0.0.2:8443" {
+		t.Errorf("Override not applied to existing host: %s", addr)
+	}
+
+	def = &ndf.NetworkDefinition{Gateways: testutils.NDF.Gateways[:1],
+		Nodes: []ndf.Node{{ID: id.TempGateway.Marshal()}}}
+	if err := gs.UpdateHosts(def, nil); err == nil {
+		t.Errorf("No error for gateway with a hard coded ID.")
+	}
+	if _, exists := gs.comms.GetHost(&id.TempGateway); exists {
+		t.Errorf("Host added for gateway with a hard coded ID.")
+	}
+}
+
+// Tests that requests sent through the GatewaySelector are recorded against
+// the host that handled them.
+func TestGatewaySelector_SendPutMessage(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gw := gateway.StartGateway(testID, gatewayAddress,
+		gateway.NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+
+	gs := newTestSelector(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := gs.comms.Manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+	gs.AddHost(host)
+
+	_, sentTo, err := gs.SendPutMessage(&pb.GatewaySlot{}, time.Minute)
+	if err != nil {
+		t.Fatalf("SendPutMessage error: %+v", err)
+	} else if sentTo != host {
+		t.Errorf("Unexpected host: %s", sentTo.GetId())
+	}
+	if !gs.scores[*testID].sampled {
+		t.Errorf("Outcome of request not recorded.")
+	}
+}
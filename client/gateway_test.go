@@ -8,6 +8,7 @@
 package client
 
 import (
+	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	"gitlab.com/elixxir/comms/gateway"
 	pb "gitlab.com/elixxir/comms/mixmessages"
@@ -15,6 +16,9 @@ import (
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/gossip"
 	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/rateLimiting"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"os"
 	"testing"
 	"time"
@@ -259,7 +263,7 @@ func (m mockGatewayImpl) RequestMessages(msg *pb.GetMessages) (*pb.GetMessagesRe
 	return nil, nil
 }
 
-func (m mockGatewayImpl) ShareMessages(msg *pb.RoundMessages, auth *connect.Auth) error {
+func (m mockGatewayImpl) ShareRoundMessages(msg *pb.RoundMessages, auth *connect.Auth) error {
 	return nil
 }
 
@@ -270,3 +274,328 @@ func (m mockGatewayImpl) RequestBatchMessages(message *pb.GetMessagesBatch) (*pb
 func (m mockGatewayImpl) RequestTlsCert(msg *pb.RequestGatewayCert) (*pb.GatewayCertificate, error) {
 	return &pb.GatewayCertificate{}, nil
 }
+
+func (m mockGatewayImpl) SubscribeRounds(msg *pb.RoundSubscription) (<-chan *pb.RoundSubscriptionUpdate, func(), error) {
+	return make(chan *pb.RoundSubscriptionUpdate), func() {}, nil
+}
+
+// Tests that Comms.SubscribeRounds resumes from the last update received when
+// the gateway closes the stream.
+func TestComms_SubscribeRounds_Resume(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	impl := gateway.NewImplementation()
+	subscriptions := make(chan *pb.RoundSubscription, 2)
+	impl.Functions.SubscribeRounds = func(msg *pb.RoundSubscription) (
+		<-chan *pb.RoundSubscriptionUpdate, func(), error) {
+		subscriptions <- msg
+		updates := make(chan *pb.RoundSubscriptionUpdate, 1)
+		updates <- &pb.RoundSubscriptionUpdate{Updates: []*pb.RoundInfo{
+			{UpdateID: msg.GetLastUpdate() + 5}}}
+
+		// Close the first subscription to force the client to resume
+		if msg.GetLastUpdate() == 0 {
+			close(updates)
+		}
+		return updates, func() {}, nil
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	var c Comms
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	updates, cancel, err := c.SubscribeRounds(host, &pb.RoundSubscription{})
+	if err != nil {
+		t.Fatalf("SubscribeRounds error: %+v", err)
+	}
+	defer cancel()
+
+	for _, expected := range []uint64{5, 10} {
+		select {
+		case update := <-updates:
+			if update.GetUpdates()[0].GetUpdateID() != expected {
+				t.Errorf("Unexpected update ID.\nexpected: %d\nreceived: %d",
+					expected, update.GetUpdates()[0].GetUpdateID())
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for update %d", expected)
+		}
+	}
+
+	<-subscriptions
+	if resumed := <-subscriptions; resumed.GetLastUpdate() != 5 {
+		t.Errorf("Subscription did not resume from the last update."+
+			"\nexpected: %d\nreceived: %d", 5, resumed.GetLastUpdate())
+	}
+}
+
+// Error path: Tests that Comms.SubscribeRounds stops reconnecting and closes
+// the channel when the gateway rejects the reopened subscription with an error
+// that reconnecting cannot fix.
+func TestComms_SubscribeRounds_PermanentError(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	impl := gateway.NewImplementation()
+	subscriptions := make(chan *pb.RoundSubscription, 3)
+	impl.Functions.SubscribeRounds = func(msg *pb.RoundSubscription) (
+		<-chan *pb.RoundSubscriptionUpdate, func(), error) {
+		subscriptions <- msg
+		if msg.GetLastUpdate() != 0 {
+			return nil, nil, status.Error(codes.PermissionDenied, "denied")
+		}
+
+		// Close the first subscription to force the client to reconnect
+		updates := make(chan *pb.RoundSubscriptionUpdate, 1)
+		updates <- &pb.RoundSubscriptionUpdate{
+			Updates: []*pb.RoundInfo{{UpdateID: 5}}}
+		close(updates)
+		return updates, func() {}, nil
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	var c Comms
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	updates, cancel, err := c.SubscribeRounds(host, &pb.RoundSubscription{})
+	if err != nil {
+		t.Fatalf("SubscribeRounds error: %+v", err)
+	}
+	defer cancel()
+
+	timeout := time.After(5 * time.Second)
+	for received := 0; ; received++ {
+		select {
+		case _, ok := <-updates:
+			if ok {
+				continue
+			} else if received != 1 {
+				t.Errorf("Unexpected number of updates: %d", received)
+			}
+		case <-timeout:
+			t.Fatal("Timed out waiting for the channel to close.")
+		}
+		break
+	}
+
+	if len(subscriptions) != 2 {
+		t.Errorf("Unexpected number of subscriptions.\nexpected: %d"+
+			"\nreceived: %d", 2, len(subscriptions))
+	}
+}
+
+// Tests that Comms.RequestMessagesStream and Comms.RequestBatchMessagesStream
+// receive responses larger than a single chunk.
+func TestComms_RequestMessagesStream(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	messages := make([]*pb.Slot, 10)
+	for i := range messages {
+		messages[i] = &pb.Slot{PayloadA: make([]byte, pb.ChunkSize)}
+	}
+	impl := gateway.NewImplementation()
+	impl.Functions.RequestMessages = func(msg *pb.GetMessages) (*pb.GetMessagesResponse, error) {
+		return &pb.GetMessagesResponse{Messages: messages, HasRound: true}, nil
+	}
+	impl.Functions.RequestBatchMessages = func(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
+		return &pb.GetMessagesResponseBatch{Results: []*pb.GetMessagesResponse{
+			{Messages: messages}, {Messages: messages}}}, nil
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	var c Comms
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	resp, err := c.RequestMessagesStream(host, &pb.GetMessages{})
+	if err != nil {
+		t.Errorf("RequestMessagesStream error: %+v", err)
+	} else if len(resp.GetMessages()) != len(messages) || !resp.GetHasRound() {
+		t.Errorf("Unexpected response with %d messages.",
+			len(resp.GetMessages()))
+	}
+
+	batchResp, err := c.RequestBatchMessagesStream(host, &pb.GetMessagesBatch{})
+	if err != nil {
+		t.Errorf("RequestBatchMessagesStream error: %+v", err)
+	} else if len(batchResp.GetResults()) != 2 {
+		t.Errorf("Unexpected response with %d results.",
+			len(batchResp.GetResults()))
+	}
+}
+
+// Tests that SendPutManyMessages returns the result of each slot and that the
+// rejected slots can be resent.
+func TestComms_SendPutManyMessages_SlotResults(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	impl := gateway.NewImplementation()
+	impl.Functions.PutManyMessages = func(msgs *pb.GatewaySlots, ipAddr string) (*pb.GatewaySlotResponse, error) {
+		return pb.NewGatewaySlotResponse(msgs.RoundID, []*pb.GatewaySlotResult{
+			pb.AcceptedSlot(msgs.RoundID),
+			pb.RejectedSlot(pb.GatewaySlotRejection_SLOT_REJECTION_BAD_MAC),
+			pb.AcceptedSlot(msgs.RoundID),
+		}), nil
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	var c Comms
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	slots := &pb.GatewaySlots{RoundID: 7, Messages: []*pb.GatewaySlot{
+		{MAC: []byte{0}}, {MAC: []byte{1}}, {MAC: []byte{2}}}}
+	resp, err := c.SendPutManyMessages(host, slots, 2*time.Minute)
+	if err != nil {
+		t.Fatalf("SendPutManyMessages error: %+v", err)
+	}
+
+	if !resp.GetAccepted() {
+		t.Errorf("Processed batch not marked as accepted.")
+	}
+	result := resp.GetSlotResult(1)
+	if result.GetStatus() != pb.GatewaySlotStatus_SLOT_REJECTED ||
+		result.GetReason() != pb.GatewaySlotRejection_SLOT_REJECTION_BAD_MAC {
+		t.Errorf("Unexpected result for rejected slot: %v", result)
+	}
+	if rejected := slots.Rejected(resp); len(rejected.GetMessages()) != 1 ||
+		rejected.GetMessages()[0].GetMAC()[0] != 1 {
+		t.Errorf("Unexpected rejected slots: %v", rejected)
+	}
+
+	// A gateway that reports results for the wrong number of slots is an
+	// error
+	slots.Messages = slots.Messages[:2]
+	if _, err = c.SendPutManyMessages(host, slots, 2*time.Minute); err == nil {
+		t.Errorf("Failed to reject mismatched slot results.")
+	}
+}
+
+// Tests that requests over the gateway's rate limits are rejected with a
+// RateLimitedError containing the time until they would be accepted.
+func TestComms_RateLimited(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gw := gateway.StartGateway(testID, gatewayAddress,
+		gateway.NewImplementation(), nil, nil, gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+	gw.SetRateLimits(gateway.RateLimitParams{
+		SenderID: rateLimiting.MapParams{
+			Capacity: 2, LeakedTokens: 1, LeakDuration: time.Hour},
+		ReceptionID: rateLimiting.MapParams{
+			Capacity: 1, LeakedTokens: 1, LeakDuration: time.Hour},
+	})
+	var c Comms
+
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	slot := &pb.GatewaySlot{Message: &pb.Slot{SenderID: []byte("sender")}}
+	for i := 0; i < 2; i++ {
+		if _, err = c.SendPutMessage(host, slot, 2*time.Minute); err != nil {
+			t.Fatalf("SendPutMessage %d error: %+v", i, err)
+		}
+	}
+	_, err = c.SendPutMessage(host, slot, 2*time.Minute)
+	var rateLimited *RateLimitedError
+	if !errors.As(err, &rateLimited) {
+		t.Fatalf("Expected RateLimitedError, received: %+v", err)
+	} else if rateLimited.RetryAfter <= 0 || rateLimited.RetryAfter > time.Hour {
+		t.Errorf("Unexpected retry after: %s", rateLimited.RetryAfter)
+	}
+
+	// A different sender is not limited
+	slot.Message.SenderID = []byte("other sender")
+	if _, err = c.SendPutMessage(host, slot, 2*time.Minute); err != nil {
+		t.Errorf("SendPutMessage error for other sender: %+v", err)
+	}
+
+	poll := &pb.GatewayPoll{ReceptionID: []byte("reception")}
+	if _, _, _, err = c.SendPoll(host, poll); err != nil {
+		t.Fatalf("SendPoll error: %+v", err)
+	}
+	if _, _, _, err = c.SendPoll(host, poll); !errors.As(err, &rateLimited) {
+		t.Errorf("Expected RateLimitedError, received: %+v", err)
+	}
+}
+
+// Tests that RequestAllMessages walks every page of a paginated response.
+func TestComms_RequestAllMessages(t *testing.T) {
+	gatewayAddress := getNextAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	messages := make([]*pb.Slot, 10)
+	for i := range messages {
+		messages[i] = &pb.Slot{Index: uint32(i)}
+	}
+	numRequests := 0
+	impl := gateway.NewImplementation()
+	impl.Functions.RequestMessages = func(msg *pb.GetMessages) (*pb.GetMessagesResponse, error) {
+		numRequests++
+		return pb.NewMessagesPage(msg, messages, true)
+	}
+	gw := gateway.StartGateway(testID, gatewayAddress, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+
+	pk := testkeys.LoadFromPath(testkeys.GetGatewayKeyPath())
+	c, err := NewClientComms(testID, nil, pk, nil)
+	if err != nil {
+		t.Fatalf("Could not start client: %v", err)
+	}
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := c.Manager.AddHost(testID, gatewayAddress, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	response, err := c.RequestAllMessages(host, &pb.GetMessages{RoundID: 3}, 4)
+	if err != nil {
+		t.Fatalf("RequestAllMessages error: %+v", err)
+	}
+	if numRequests != 3 {
+		t.Errorf("Unexpected number of requests: %d", numRequests)
+	}
+	if !response.HasRound || len(response.Messages) != len(messages) {
+		t.Fatalf("Unexpected response: %v", response)
+	}
+	for i, slot := range response.Messages {
+		if slot.Index != uint32(i) {
+			t.Errorf("Message %d out of order: %d", i, slot.Index)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains an iterator over the pages of a paginated message request
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// Error messages.
+const (
+	messagePagesDoneErr     = "no more message pages for round %d"
+	repeatedContinuationErr = "gateway returned the same continuation token twice for round %d"
+)
+
+// MessagePages iterates over the pages of messages for a client in a round,
+// requesting each page from the gateway with RequestMessages. Gateways that do
+// not support pagination return all messages in the first page.
+type MessagePages struct {
+	comms   *Comms
+	host    *connect.Host
+	request *pb.GetMessages
+	done    bool
+}
+
+// NewMessagePages returns a MessagePages that requests the messages described
+// by the message from the host, at most pageSize messages at a time. The
+// message is not modified.
+func (c *Comms) NewMessagePages(host *connect.Host, message *pb.GetMessages,
+	pageSize uint32) *MessagePages {
+	request := proto.Clone(message).(*pb.GetMessages)
+	request.PageSize = pageSize
+	request.ContinuationToken = nil
+
+	return &MessagePages{
+		comms:   c,
+		host:    host,
+		request: request,
+	}
+}
+
+// HasNext returns true if there are more pages to request.
+func (mp *MessagePages) HasNext() bool {
+	return !mp.done
+}
+
+// Next requests the next page of messages. Once the last page has been
+// returned, HasNext returns false. If the request fails, Next may be called
+// again to retry the same page.
+func (mp *MessagePages) Next() (*pb.GetMessagesResponse, error) {
+	return mp.NextWithContext(context.Background())
+}
+
+// NextWithContext is Next with a context that can cancel the request and set
+// its deadline.
+func (mp *MessagePages) NextWithContext(ctx context.Context) (
+	*pb.GetMessagesResponse, error) {
+	if mp.done {
+		return nil, errors.Errorf(messagePagesDoneErr, mp.request.RoundID)
+	}
+
+	response, err := mp.comms.RequestMessagesWithContext(ctx, mp.host, mp.request)
+	if err != nil {
+		return nil, err
+	}
+
+	token := response.GetContinuationToken()
+	if len(token) == 0 {
+		mp.done = true
+	} else if bytes.Equal(token, mp.request.ContinuationToken) {
+		mp.done = true
+		return nil, errors.Errorf(repeatedContinuationErr, mp.request.RoundID)
+	}
+	mp.request.ContinuationToken = token
+
+	return response, nil
+}
+
+// RequestAllMessages walks all pages of messages for the client in the round,
+// pageSize messages at a time, and returns them combined in one response.
+func (c *Comms) RequestAllMessages(host *connect.Host,
+	message *pb.GetMessages, pageSize uint32) (*pb.GetMessagesResponse, error) {
+	return c.RequestAllMessagesWithContext(
+		context.Background(), host, message, pageSize)
+}
+
+// RequestAllMessagesWithContext is RequestAllMessages with a context that can
+// cancel the requests and set a deadline for all pages.
+func (c *Comms) RequestAllMessagesWithContext(ctx context.Context,
+	host *connect.Host, message *pb.GetMessages, pageSize uint32) (
+	*pb.GetMessagesResponse, error) {
+	result := &pb.GetMessagesResponse{}
+	for pages := c.NewMessagePages(host, message, pageSize); pages.HasNext(); {
+		page, err := pages.NextWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.Messages = append(result.Messages, page.GetMessages()...)
+		result.HasRound = page.GetHasRound()
+	}
+
+	return result, nil
+}
@@ -10,9 +10,9 @@
 package client
 
 import (
+	"context"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
-	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
@@ -21,25 +21,35 @@ import (
 
 // Client -> NotificationBot
 func (c *Comms) RegisterForNotifications(host *connect.Host,
+	message *pb.NotificationRegisterRequest) (*messages.Ack, error) {
+	return c.RegisterForNotificationsWithContext(context.Background(),
+		host, message)
+}
+
+// RegisterForNotificationsWithContext is RegisterForNotifications with a
+// context that can cancel the send and set its deadline.
+func (c *Comms) RegisterForNotificationsWithContext(ctx context.Context,
+	host *connect.Host,
 	message *pb.NotificationRegisterRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
 		resultMsg, err := pb.NewNotificationBotClient(conn.GetGrpcConn()).
 			RegisterForNotifications(ctx, message)
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending RegisterForNotification message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Notifications, f)
 	if err != nil {
 		return nil, err
 	}
@@ -52,24 +62,33 @@ func (c *Comms) RegisterForNotifications(host *connect.Host,
 
 // Client -> NotificationBot
 func (c *Comms) UnregisterForNotifications(host *connect.Host, message *pb.NotificationUnregisterRequest) (*messages.Ack, error) {
+	return c.UnregisterForNotificationsWithContext(context.Background(),
+		host, message)
+}
+
+// UnregisterForNotificationsWithContext is UnregisterForNotifications with a
+// context that can cancel the send and set its deadline.
+func (c *Comms) UnregisterForNotificationsWithContext(ctx context.Context,
+	host *connect.Host, message *pb.NotificationUnregisterRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
 		resultMsg, err := pb.NewNotificationBotClient(conn.GetGrpcConn()).
 			UnregisterForNotifications(ctx, message)
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending UnregisterForNotification message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Notifications, f)
 	if err != nil {
 		return nil, err
 	}
@@ -8,10 +8,13 @@
 package client
 
 import (
+	"github.com/pkg/errors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/elixxir/comms/notificationBot"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"testing"
 )
 
@@ -82,3 +85,42 @@ func TestUnregisterForNotifications(t *testing.T) {
 	}
 
 }
+
+// Error path: Tests that RegisterForNotifications returns the gRPC status of
+// the notification bot's error.
+func TestRegisterForNotifications_Status(t *testing.T) {
+	testId := id.NewIdFromString("test", id.Generic, t)
+	clientId := id.NewIdFromString("client", id.Generic, t)
+
+	// Start notification bot
+	nbAddress := getNextAddress()
+	impl := notificationBot.NewImplementation()
+	impl.Functions.RegisterForNotifications =
+		func(*pb.NotificationRegisterRequest) error {
+			return status.Error(codes.InvalidArgument, "invalid token")
+		}
+	nb := notificationBot.StartNotificationBot(testId, nbAddress, impl, nil, nil)
+	defer nb.Shutdown()
+
+	// Create client's comms object
+	c, err := NewClientComms(clientId, nil, nil, nil)
+	if err != nil {
+		t.Errorf("Can't create client comms: %+v", err)
+	}
+	manager := connect.NewManagerTesting(t)
+
+	// Add notification bot to comm's manager
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testId, nbAddress, nil, params)
+	if err != nil {
+		t.Errorf("Unable to call NewHost: %+v", err)
+	}
+
+	_, err = c.RegisterForNotifications(host, &pb.NotificationRegisterRequest{})
+	var statusErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &statusErr) ||
+		statusErr.GRPCStatus().Code() != codes.InvalidArgument {
+		t.Errorf("gRPC status not returned: %+v", err)
+	}
+}
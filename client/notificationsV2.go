@@ -1,9 +1,9 @@
 package client
 
 import (
+	"context"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
-	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
@@ -15,10 +15,17 @@ import (
 // The actual ID is not revealed, instead an intermediary value is sent which cannot
 // be revered to get the ID, but is repeatable. So it can be rainbow-tabled.
 func (c *Comms) RegisterTrackedID(host *connect.Host, message *pb.RegisterTrackedIdRequest) (*messages.Ack, error) {
+	return c.RegisterTrackedIDWithContext(context.Background(), host, message)
+}
+
+// RegisterTrackedIDWithContext is RegisterTrackedID with a context that can
+// cancel the send and set its deadline.
+func (c *Comms) RegisterTrackedIDWithContext(ctx context.Context,
+	host *connect.Host, message *pb.RegisterTrackedIdRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -33,14 +40,15 @@ func (c *Comms) RegisterTrackedID(host *connect.Host, message *pb.RegisterTracke
 				RegisterTrackedID(ctx, message)
 		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending RegisterTrackedID message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Notifications, f)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +61,17 @@ func (c *Comms) RegisterTrackedID(host *connect.Host, message *pb.RegisterTracke
 // UnregisterTrackedID unregisters the given tracked ID. The request is signed.
 // Does not return an error if the token cannot be found
 func (c *Comms) UnregisterTrackedID(host *connect.Host, message *pb.UnregisterTrackedIdRequest) (*messages.Ack, error) {
+	return c.UnregisterTrackedIDWithContext(context.Background(), host, message)
+}
+
+// UnregisterTrackedIDWithContext is UnregisterTrackedID with a context that can
+// cancel the send and set its deadline.
+func (c *Comms) UnregisterTrackedIDWithContext(ctx context.Context,
+	host *connect.Host, message *pb.UnregisterTrackedIdRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -71,14 +86,15 @@ func (c *Comms) UnregisterTrackedID(host *connect.Host, message *pb.UnregisterTr
 				UnregisterTrackedID(ctx, message)
 		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending UnregisterTrackedID message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Notifications, f)
 	if err != nil {
 		return nil, err
 	}
@@ -92,10 +108,17 @@ func (c *Comms) UnregisterTrackedID(host *connect.Host, message *pb.UnregisterTr
 // correct. The RSA->PEM relationship is one to many. It will succeed if the token is already
 // registered.
 func (c *Comms) RegisterToken(host *connect.Host, message *pb.RegisterTokenRequest) (*messages.Ack, error) {
+	return c.RegisterTokenWithContext(context.Background(), host, message)
+}
+
+// RegisterTokenWithContext is RegisterToken with a context that can cancel the
+// send and set its deadline.
+func (c *Comms) RegisterTokenWithContext(ctx context.Context,
+	host *connect.Host, message *pb.RegisterTokenRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -110,14 +133,15 @@ func (c *Comms) RegisterToken(host *connect.Host, message *pb.RegisterTokenReque
 				RegisterToken(ctx, message)
 		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending RegisterToken message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Notifications, f)
 	if err != nil {
 		return nil, err
 	}
@@ -130,10 +154,17 @@ func (c *Comms) RegisterToken(host *connect.Host, message *pb.RegisterTokenReque
 // UnregisterToken unregisters the given token. The request is signed.
 // Does not return an error if the token cannot be found
 func (c *Comms) UnregisterToken(host *connect.Host, message *pb.UnregisterTokenRequest) (*messages.Ack, error) {
+	return c.UnregisterTokenWithContext(context.Background(), host, message)
+}
+
+// UnregisterTokenWithContext is UnregisterToken with a context that can cancel
+// the send and set its deadline.
+func (c *Comms) UnregisterTokenWithContext(ctx context.Context,
+	host *connect.Host, message *pb.UnregisterTokenRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -148,14 +179,15 @@ func (c *Comms) UnregisterToken(host *connect.Host, message *pb.UnregisterTokenR
 				UnregisterToken(ctx, message)
 		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending UnregisterToken message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Notifications, f)
 	if err != nil {
 		return nil, err
 	}
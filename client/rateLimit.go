@@ -0,0 +1,45 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the error returned when a gateway rate limits the client
+
+package client
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"time"
+)
+
+// RateLimitedError is returned by SendPutMessage, SendPutManyMessages,
+// SendPoll, RequestMessages and RequestMessagesStream, and their WithContext
+// variants, when the gateway rejects the request because the client is over
+// one of its rate limits. The request should not be sent to the same gateway
+// again before RetryAfter has passed.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+	err        error
+}
+
+// Error returns the error received from the gateway.
+func (e *RateLimitedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error received from the gateway, which is a
+// ResourceExhausted gRPC status error.
+func (e *RateLimitedError) Unwrap() error {
+	return e.err
+}
+
+// checkRateLimited returns a RateLimitedError if the error was returned by a
+// gateway rate limit and returns the error unchanged otherwise.
+func checkRateLimited(err error) error {
+	if retryAfter, ok := pb.GetRetryAfter(err); ok {
+		return &RateLimitedError{RetryAfter: retryAfter, err: err}
+	}
+	return err
+}
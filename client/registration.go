@@ -10,9 +10,8 @@
 package client
 
 import (
+	"context"
 	"crypto/sha256"
-	"strings"
-	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
@@ -27,11 +26,20 @@ import (
 // Client -> Registration Send Function
 func (c *Comms) SendRegistrationMessage(host *connect.Host,
 	message *pb.ClientRegistration) (*pb.SignedClientRegistrationConfirmations, error) {
+	return c.SendRegistrationMessageWithContext(context.Background(),
+		host, message)
+}
+
+// SendRegistrationMessageWithContext is SendRegistrationMessage with a context
+// that can cancel the send and set its deadline.
+func (c *Comms) SendRegistrationMessageWithContext(ctx context.Context,
+	host *connect.Host,
+	message *pb.ClientRegistration) (*pb.SignedClientRegistrationConfirmations, error) {
 
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -53,7 +61,8 @@ func (c *Comms) SendRegistrationMessage(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Registration message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Registration, f)
 	if err != nil {
 		return nil, err
 	}
@@ -68,11 +77,19 @@ func (c *Comms) SendRegistrationMessage(host *connect.Host,
 // function should never be used as clients.
 func (c *Comms) RequestNdf(host *connect.Host,
 	message *pb.NDFHash) (*pb.NDF, error) {
+	return c.RequestNdfWithContext(context.Background(), host, message)
+}
+
+// RequestNdfWithContext is RequestNdf with a context that can cancel the send
+// and set its deadline.
+func (c *Comms) RequestNdfWithContext(ctx context.Context,
+	host *connect.Host,
+	message *pb.NDFHash) (*pb.NDF, error) {
 
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -86,7 +103,8 @@ func (c *Comms) RequestNdf(host *connect.Host,
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Request Ndf message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().Registration, f)
 	if err != nil {
 		return nil, err
 	}
@@ -96,8 +114,17 @@ func (c *Comms) RequestNdf(host *connect.Host,
 
 }
 
-// RetrieveNdf, attempts to connect to the permissioning server to retrieve the latest ndf for the notifications bot
+// RetrieveNdf, attempts to connect to the permissioning server to retrieve the latest ndf for the notifications bot.
+// While permissioning has no NDF, the request is retried as described by the
+// Ndf retry policy.
 func (c *Comms) RetrieveNdf(currentDef *ndf.NetworkDefinition) (*ndf.NetworkDefinition, error) {
+	return c.RetrieveNdfWithContext(context.Background(), currentDef)
+}
+
+// RetrieveNdfWithContext is RetrieveNdf with a context that can cancel the
+// retrieval and set its deadline, including while waiting to ask again.
+func (c *Comms) RetrieveNdfWithContext(ctx context.Context,
+	currentDef *ndf.NetworkDefinition) (*ndf.NetworkDefinition, error) {
 	// Hash the notifications bot ndf for comparison with registration's ndf
 	var ndfHash []byte
 	// If the ndf passed not nil, serialize and hash it
@@ -119,23 +146,17 @@ func (c *Comms) RetrieveNdf(currentDef *ndf.NetworkDefinition) (*ndf.NetworkDefi
 		return nil, errors.New("Failed to find permissioning host")
 	}
 
-	// Send the hash to registration
-	response, err := c.RequestNdf(regHost, msg)
-
-	// Keep going until we get a grpc error or we get an ndf
-	for err != nil {
-		// If there is an unexpected error
-		if !strings.Contains(err.Error(), ndf.NO_NDF) {
-			// If it is not an issue with no ndf, return the error up the stack
-			errMsg := errors.Errorf("Failed to get ndf from permissioning: %v", err)
-			return nil, errMsg
-		}
-
-		// If the error is that the permissioning server is not ready, ask again
-		jww.WARN.Println("Failed to get an ndf, possibly not ready yet. Retying now...")
-		time.Sleep(250 * time.Millisecond)
-		response, err = c.RequestNdf(regHost, msg)
-
+	// Send the hash to registration, asking again while permissioning is not
+	// ready as described by the NDF retry policy
+	var response *pb.NDF
+	err := c.GetRetryPolicies().Ndf.do(ctx, "NDF request",
+		func(ctx context.Context) error {
+			var err error
+			response, err = c.RequestNdfWithContext(ctx, regHost, msg)
+			return err
+		})
+	if err != nil {
+		return nil, errors.Errorf("Failed to get ndf from permissioning: %v", err)
 	}
 
 	// If there was no error and the response is nil, client's ndf is up-to-date
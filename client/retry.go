@@ -0,0 +1,245 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the retry policies applied to the client send functions
+
+package client
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/ndf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Error messages.
+const (
+	retriesExhaustedErr = "giving up after %d attempts"
+	retryAbortedErr     = "giving up after %d attempts: %v"
+)
+
+// RetryPolicy describes how a failed request is retried. A request is retried
+// if its error has one of the RetryableCodes or contains one of the
+// RetryableErrors, until MaxAttempts attempts have been made or Deadline has
+// passed. The zero value never retries.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first. If zero, attempts are
+	// only limited by Deadline and the caller's context.
+	MaxAttempts int
+
+	// Wait before the first retry. Each further wait is BackoffMultiplier
+	// times longer, up to MaxBackoff.
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+
+	// Fraction of each wait that is randomised, in [0, 1], so that clients
+	// failing together do not retry together
+	Jitter float64
+
+	// gRPC status codes that are retried
+	RetryableCodes []codes.Code
+
+	// Substrings of error messages that are retried, for servers that report
+	// retryable conditions in plain errors rather than with a status code
+	RetryableErrors []string
+
+	// Time after the first attempt after which no further attempts are made
+	// and any attempt in progress is cancelled. If zero, there is no deadline
+	// other than the caller's context.
+	Deadline time.Duration
+}
+
+// RetryPolicies contains the RetryPolicy for each family of client send
+// functions. A zero RetryPolicy is replaced by the one from
+// DefaultRetryPolicies.
+type RetryPolicies struct {
+	// SendPoll
+	Poll RetryPolicy
+
+	// SendPutMessage and SendPutManyMessages
+	Put RetryPolicy
+
+	// RequestMessages, RequestBatchMessages, their streamed variants and
+	// RequestHistoricalRounds
+	Messages RetryPolicy
+
+	// SendRequestClientKeyMessage, BatchNodeRegistration,
+	// GetGatewayTLSCertificate, SendRegistrationMessage and RequestNdf
+	Registration RetryPolicy
+
+	// User discovery send functions
+	UD RetryPolicy
+
+	// Notification bot send functions
+	Notifications RetryPolicy
+
+	// RetrieveNdf, which retries RequestNdf until permissioning has an NDF
+	Ndf RetryPolicy
+}
+
+// DefaultRetryPolicies returns the default RetryPolicies. The send functions
+// make a single attempt, as they did before retry policies existed; set
+// retries with SetRetryPolicies. RetrieveNdf retries until permissioning has
+// an NDF.
+func DefaultRetryPolicies() RetryPolicies {
+	ndfPolicy := newRetryPolicy(0)
+	ndfPolicy.InitialBackoff = 250 * time.Millisecond
+	ndfPolicy.MaxBackoff = 5 * time.Second
+	ndfPolicy.BackoffMultiplier = 1.5
+	ndfPolicy.RetryableErrors = []string{ndf.NO_NDF}
+
+	return RetryPolicies{
+		Poll:          newRetryPolicy(1),
+		Put:           newRetryPolicy(1),
+		Messages:      newRetryPolicy(1),
+		Registration:  newRetryPolicy(1),
+		UD:            newRetryPolicy(1),
+		Notifications: newRetryPolicy(1),
+		Ndf:           ndfPolicy,
+	}
+}
+
+// withDefaults returns the policies with each zero RetryPolicy replaced by its
+// default, such as for Comms not built with NewClientComms.
+func (p RetryPolicies) withDefaults() RetryPolicies {
+	defaults := DefaultRetryPolicies()
+	for _, policy := range []struct{ set, def *RetryPolicy }{
+		{&p.Poll, &defaults.Poll},
+		{&p.Put, &defaults.Put},
+		{&p.Messages, &defaults.Messages},
+		{&p.Registration, &defaults.Registration},
+		{&p.UD, &defaults.UD},
+		{&p.Notifications, &defaults.Notifications},
+		{&p.Ndf, &defaults.Ndf},
+	} {
+		if reflect.ValueOf(*policy.set).IsZero() {
+			*policy.set = *policy.def
+		}
+	}
+
+	return p
+}
+
+// newRetryPolicy returns a RetryPolicy with the default backoff that makes at
+// most maxAttempts attempts and retries nothing.
+func newRetryPolicy(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       maxAttempts,
+		InitialBackoff:    100 * time.Millisecond,
+		MaxBackoff:        2 * time.Second,
+		BackoffMultiplier: 2,
+		Jitter:            0.2,
+	}
+}
+
+// SetRetryPolicies sets the retry policies used by the send functions.
+func (c *Comms) SetRetryPolicies(policies RetryPolicies) {
+	c.retryMux.Lock()
+	defer c.retryMux.Unlock()
+	c.retryPolicies = policies
+}
+
+// GetRetryPolicies returns the retry policies used by the send functions. A
+// policy that has not been set, or was set to the zero RetryPolicy, is its
+// default.
+func (c *Comms) GetRetryPolicies() RetryPolicies {
+	c.retryMux.RLock()
+	defer c.retryMux.RUnlock()
+	return c.retryPolicies.withDefaults()
+}
+
+// do calls the function until it succeeds or returns an error the policy does
+// not retry, waiting between attempts. The context passed to the function is
+// cancelled with the parent or once the policy's deadline passes. The error of
+// the last attempt is returned.
+func (p RetryPolicy) do(ctx context.Context, description string,
+	f func(ctx context.Context) error) error {
+	if p.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Deadline)
+		defer cancel()
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := f(ctx)
+		if err == nil || !p.isRetryable(err) {
+			return err
+		}
+
+		if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+			if attempt == 1 {
+				return err
+			}
+			return errors.WithMessagef(err, retriesExhaustedErr, attempt)
+		}
+
+		wait := p.backoff(attempt)
+		if retryAfter, ok := pb.GetRetryAfter(err); ok && retryAfter > wait {
+			wait = retryAfter
+		}
+
+		jww.WARN.Printf("Attempt %d of %s failed, retrying in %s: %v",
+			attempt, description, wait, err)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.WithMessagef(err, retryAbortedErr, attempt, ctx.Err())
+		}
+	}
+}
+
+// isRetryable returns true if the policy retries the error.
+func (p RetryPolicy) isRetryable(err error) bool {
+	var statusErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &statusErr) {
+		code := statusErr.GRPCStatus().Code()
+		for _, retryable := range p.RetryableCodes {
+			if code == retryable {
+				return true
+			}
+		}
+	}
+
+	for _, retryable := range p.RetryableErrors {
+		if strings.Contains(err.Error(), retryable) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait after the given attempt, with jitter applied.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.BackoffMultiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		wait *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+
+	return time.Duration(wait)
+}
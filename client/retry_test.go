@@ -0,0 +1,240 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/ndf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestRetryPolicy returns a policy with short backoffs that retries
+// Unavailable errors.
+func newTestRetryPolicy(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       maxAttempts,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        5 * time.Millisecond,
+		BackoffMultiplier: 2,
+		RetryableCodes:    []codes.Code{codes.Unavailable},
+	}
+}
+
+// Tests that RetryPolicy.do retries retryable errors until the function
+// succeeds.
+func TestRetryPolicy_do(t *testing.T) {
+	attempts := 0
+	err := newTestRetryPolicy(5).do(context.Background(), "test",
+		func(context.Context) error {
+			if attempts++; attempts < 3 {
+				return status.Error(codes.Unavailable, "unavailable")
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("do returned an error: %+v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, made %d", attempts)
+	}
+}
+
+// Tests that RetryPolicy.do does not retry errors with other codes, including
+// wrapped ones.
+func TestRetryPolicy_do_NotRetryable(t *testing.T) {
+	attempts := 0
+	expected := status.Error(codes.InvalidArgument, "invalid")
+	err := newTestRetryPolicy(5).do(context.Background(), "test",
+		func(context.Context) error {
+			attempts++
+			return errors.WithMessage(expected, "wrapped")
+		})
+	if errors.Cause(err) != expected {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, made %d", attempts)
+	}
+}
+
+// Tests that RetryPolicy.do stops after MaxAttempts and returns the last error.
+func TestRetryPolicy_do_MaxAttempts(t *testing.T) {
+	attempts := 0
+	err := newTestRetryPolicy(4).do(context.Background(), "test",
+		func(context.Context) error {
+			attempts++
+			return status.Error(codes.Unavailable, "unavailable")
+		})
+	if status.Code(errors.Cause(err)) != codes.Unavailable {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if attempts != 4 {
+		t.Errorf("Expected 4 attempts, made %d", attempts)
+	}
+}
+
+// Tests that RetryPolicy.do retries errors containing a retryable message.
+func TestRetryPolicy_do_RetryableErrors(t *testing.T) {
+	policy := newTestRetryPolicy(0)
+	policy.RetryableErrors = []string{ndf.NO_NDF}
+
+	attempts := 0
+	err := policy.do(context.Background(), "test",
+		func(context.Context) error {
+			if attempts++; attempts < 5 {
+				return errors.New(ndf.NO_NDF)
+			}
+			return nil
+		})
+	if err != nil || attempts != 5 {
+		t.Errorf("Expected success after 5 attempts, made %d: %+v",
+			attempts, err)
+	}
+}
+
+// Tests that RetryPolicy.do stops once its deadline has passed and that the
+// context passed to the function expires with it.
+func TestRetryPolicy_do_Deadline(t *testing.T) {
+	policy := newTestRetryPolicy(0)
+	policy.Deadline = 50 * time.Millisecond
+
+	start := time.Now()
+	err := policy.do(context.Background(), "test",
+		func(ctx context.Context) error {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("Context passed to function has no deadline")
+			}
+			return status.Error(codes.Unavailable, "unavailable")
+		})
+	if status.Code(errors.Cause(err)) != codes.Unavailable {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("do took %s after a deadline of %s", elapsed, policy.Deadline)
+	}
+}
+
+// Tests that RetryPolicy.do waits at least as long as a rate limited error
+// asks for.
+func TestRetryPolicy_do_RetryAfter(t *testing.T) {
+	policy := newTestRetryPolicy(2)
+	policy.RetryableCodes = []codes.Code{codes.ResourceExhausted}
+	retryAfter := 50 * time.Millisecond
+
+	var times []time.Time
+	err := policy.do(context.Background(), "test",
+		func(context.Context) error {
+			times = append(times, time.Now())
+			if len(times) == 1 {
+				return pb.NewRateLimitedError("test", retryAfter)
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("do returned an error: %+v", err)
+	}
+	if wait := times[1].Sub(times[0]); wait < retryAfter {
+		t.Errorf("Retried after %s, before %s", wait, retryAfter)
+	}
+}
+
+// Tests that the zero RetryPolicy makes a single attempt.
+func TestRetryPolicy_do_Zero(t *testing.T) {
+	attempts := 0
+	_ = RetryPolicy{}.do(context.Background(), "test",
+		func(context.Context) error {
+			attempts++
+			return status.Error(codes.Unavailable, "unavailable")
+		})
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, made %d", attempts)
+	}
+}
+
+// Tests that RetryPolicy.backoff grows exponentially up to MaxBackoff and
+// stays within the jitter.
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff:    100 * time.Millisecond,
+		MaxBackoff:        time.Second,
+		BackoffMultiplier: 2,
+		Jitter:            0.2,
+	}
+
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond,
+		400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	for i, e := range expected {
+		for j := 0; j < 20; j++ {
+			wait := policy.backoff(i + 1)
+			if wait < e*8/10 || wait > e*12/10 {
+				t.Errorf("Backoff after attempt %d is %s, expected %s ± 20%%",
+					i+1, wait, e)
+			}
+		}
+	}
+}
+
+// Tests that the retry policies set on the comms are used by the send
+// functions.
+func TestComms_SetRetryPolicies(t *testing.T) {
+	attempts := 0
+	c, host := startContextTestGateway(t,
+		func(*pb.GetMessages) (*pb.GetMessagesResponse, error) {
+			if attempts++; attempts < 3 {
+				return nil, status.Error(codes.Unavailable, "unavailable")
+			}
+			return &pb.GetMessagesResponse{HasRound: true}, nil
+		})
+
+	policies := c.GetRetryPolicies()
+	policies.Messages = newTestRetryPolicy(3)
+	c.SetRetryPolicies(policies)
+
+	response, err := c.RequestMessages(host, &pb.GetMessages{})
+	if err != nil {
+		t.Fatalf("RequestMessages error: %+v", err)
+	}
+	if !response.GetHasRound() || attempts != 3 {
+		t.Errorf("Unexpected response after %d attempts: %v",
+			attempts, response)
+	}
+}
+
+// Tests that Comms not built with NewClientComms use the default policies and
+// that the defaults make a single attempt for all but RetrieveNdf.
+func TestComms_GetRetryPolicies_Default(t *testing.T) {
+	defaults := DefaultRetryPolicies()
+	policies := (&Comms{}).GetRetryPolicies()
+	if !reflect.DeepEqual(policies, defaults) {
+		t.Errorf("Unexpected policies.\nexpected: %+v\nreceived: %+v",
+			defaults, policies)
+	}
+
+	for name, policy := range map[string]RetryPolicy{
+		"Poll": policies.Poll, "Put": policies.Put,
+		"Messages": policies.Messages, "Registration": policies.Registration,
+		"UD": policies.UD, "Notifications": policies.Notifications} {
+		if policy.MaxAttempts != 1 {
+			t.Errorf("%s makes %d attempts by default", name, policy.MaxAttempts)
+		}
+	}
+
+	// Zero policies are replaced by their default
+	c := &Comms{}
+	c.SetRetryPolicies(RetryPolicies{Poll: newTestRetryPolicy(5)})
+	if policies = c.GetRetryPolicies(); policies.Poll.MaxAttempts != 5 ||
+		!reflect.DeepEqual(policies.Ndf, defaults.Ndf) {
+		t.Errorf("Unexpected policies: %+v", policies)
+	}
+}
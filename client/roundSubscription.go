@@ -0,0 +1,238 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the client side of the gateway round subscription stream
+
+package client
+
+import (
+	"context"
+	"git.xx.network/elixxir/grpc-web-go-client/grpcweb"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	ds "gitlab.com/elixxir/comms/network/dataStructures"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Round subscription reconnection parameters.
+const (
+	// roundSubscriptionBufLen is the size of the channel returned by
+	// Comms.SubscribeRounds.
+	roundSubscriptionBufLen = 64
+
+	// roundSubscriptionRetryMinDelay is the delay before the first attempt to
+	// reopen a failed subscription stream. It doubles on each failed attempt
+	// up to roundSubscriptionRetryMaxDelay.
+	roundSubscriptionRetryMinDelay = 250 * time.Millisecond
+	roundSubscriptionRetryMaxDelay = 30 * time.Second
+)
+
+// SubscribeRounds subscribes to round and NDF updates from the gateway and
+// returns a channel that receives each update, along with a function that
+// closes the subscription. The first update contains all round updates after
+// message.LastUpdate and the partial NDF if its hash differs from the one in
+// the message.
+//
+// If the stream fails after it has been opened, it is reopened in the
+// background with exponential backoff until the subscription is closed,
+// resuming from the last update ID and NDF received. The subscription is
+// closed instead if the gateway rejects it with an error that reopening cannot
+// fix. The channel is closed once the subscription is closed.
+func (c *Comms) SubscribeRounds(host *connect.Host,
+	message *pb.RoundSubscription) (<-chan *pb.RoundSubscriptionUpdate, func(), error) {
+	return c.SubscribeRoundsWithContext(context.Background(), host, message)
+}
+
+// SubscribeRoundsWithContext is SubscribeRounds with a context that closes the
+// subscription when it is done, in the same way as the returned function.
+func (c *Comms) SubscribeRoundsWithContext(ctx context.Context,
+	host *connect.Host, message *pb.RoundSubscription) (
+	<-chan *pb.RoundSubscriptionUpdate, func(), error) {
+	// Create streaming context so the subscription can be closed later
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.openRoundSubscription(ctx, host, message)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	updates := make(chan *pb.RoundSubscriptionUpdate, roundSubscriptionBufLen)
+	go c.subscribeRounds(ctx, host, message, stream, updates)
+
+	return updates, cancel, nil
+}
+
+// subscribeRounds forwards updates from the stream to the channel, reopening
+// the stream from the last update received when it fails, until the context
+// is cancelled or the stream fails permanently.
+func (c *Comms) subscribeRounds(ctx context.Context, host *connect.Host,
+	message *pb.RoundSubscription, stream pb.Gateway_SubscribeRoundsClient,
+	updates chan<- *pb.RoundSubscriptionUpdate) {
+	defer close(updates)
+
+	// Track the position in the update stream to resume from
+	resume := &pb.RoundSubscription{
+		Partial:       message.GetPartial(),
+		LastUpdate:    message.GetLastUpdate(),
+		ClientVersion: message.GetClientVersion(),
+		FastPolling:   message.GetFastPolling(),
+	}
+
+	for {
+		// Forward updates until the stream fails
+		update, err := stream.Recv()
+		for ; err == nil; update, err = stream.Recv() {
+			for _, info := range update.GetUpdates() {
+				if info.GetUpdateID() > resume.LastUpdate {
+					resume.LastUpdate = info.GetUpdateID()
+				}
+			}
+			if update.GetPartialNDF() != nil {
+				hash, hashErr := ds.GenerateNDFHash(update.GetPartialNDF())
+				if hashErr != nil {
+					jww.WARN.Printf("Failed to hash NDF received on round "+
+						"subscription: %+v", hashErr)
+				} else {
+					resume.Partial = &pb.NDFHash{Hash: hash}
+				}
+			}
+
+			select {
+			case updates <- update:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		} else if isPermanentRoundSubscriptionErr(err) {
+			jww.ERROR.Printf("Round subscription on %s failed permanently at "+
+				"update %d: %+v", host.GetId(), resume.LastUpdate, err)
+			return
+		}
+		jww.WARN.Printf("Round subscription on %s failed at update %d, "+
+			"reconnecting: %+v", host.GetId(), resume.LastUpdate, err)
+
+		// Reopen the stream with exponential backoff
+		for delay := roundSubscriptionRetryMinDelay; ; {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			stream, err = c.openRoundSubscription(ctx, host, resume)
+			if err == nil {
+				break
+			} else if ctx.Err() != nil {
+				return
+			} else if isPermanentRoundSubscriptionErr(err) {
+				jww.ERROR.Printf("Failed to reopen round subscription on %s, "+
+					"stopping: %+v", host.GetId(), err)
+				return
+			}
+
+			if delay *= 2; delay > roundSubscriptionRetryMaxDelay {
+				delay = roundSubscriptionRetryMaxDelay
+			}
+			jww.WARN.Printf("Failed to reopen round subscription on %s, "+
+				"retrying in %s: %+v", host.GetId(), delay, err)
+		}
+	}
+}
+
+// isPermanentRoundSubscriptionErr returns true if the subscription failed for
+// a reason that reopening it cannot fix: the gateway does not support round
+// subscriptions or rejected the client or the request.
+func isPermanentRoundSubscriptionErr(err error) bool {
+	var statusErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	switch statusErr.GRPCStatus().Code() {
+	case codes.Unimplemented, codes.Unauthenticated, codes.PermissionDenied,
+		codes.InvalidArgument:
+		return true
+	default:
+		return false
+	}
+}
+
+// openRoundSubscription opens a new SubscribeRounds stream and waits for the
+// gateway to confirm that the subscription has been registered.
+func (c *Comms) openRoundSubscription(ctx context.Context, host *connect.Host,
+	message *pb.RoundSubscription) (pb.Gateway_SubscribeRoundsClient, error) {
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			clientStream, err := wc.NewServerStream(
+				&grpc.StreamDesc{ServerStreams: true},
+				"/mixmessages.Gateway/SubscribeRounds")
+			if err != nil {
+				return nil, err
+			}
+			if err = clientStream.Send(ctx, message); err != nil {
+				return nil, err
+			}
+			return newRoundSubscriptionStream(ctx, clientStream), nil
+		}
+		return pb.NewGatewayClient(conn.GetGrpcConn()).
+			SubscribeRounds(ctx, message)
+	}
+
+	// Execute the Stream function
+	jww.TRACE.Printf("Opening round subscription: %+v", message)
+	resultClient, err := c.streamWithContext(ctx, host, f)
+	if err != nil {
+		return nil, err
+	}
+	stream := resultClient.(pb.Gateway_SubscribeRoundsClient)
+
+	// The gateway sends the header once the subscription is registered; if
+	// it is missing, the subscription was rejected and the stream holds the
+	// error
+	md, err := stream.Header()
+	if err == nil && len(md.Get(pb.RoundSubscriptionHeader)) == 0 {
+		if _, err = stream.Recv(); err == nil {
+			err = errors.Errorf(pb.NoStreamingHeaderErr, host.GetId())
+		}
+	}
+	if err != nil {
+		return nil, errors.WithMessagef(err, "Could not open round "+
+			"subscription on %s", host.GetId())
+	}
+
+	return stream, nil
+}
+
+// roundSubscriptionStream wraps a serverStream to make it adhere to the
+// pb.Gateway_SubscribeRoundsClient interface.
+type roundSubscriptionStream struct {
+	*serverStream
+}
+
+func newRoundSubscriptionStream(ctx context.Context,
+	ws grpcweb.ServerStream) pb.Gateway_SubscribeRoundsClient {
+	return &roundSubscriptionStream{&serverStream{ctx, ws}}
+}
+
+func (s *roundSubscriptionStream) Recv() (*pb.RoundSubscriptionUpdate, error) {
+	m := new(pb.RoundSubscriptionUpdate)
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
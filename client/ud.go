@@ -8,9 +8,9 @@
 package client
 
 import (
+	"context"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
-	"github.com/pkg/errors"
 	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
@@ -19,10 +19,17 @@ import (
 
 // Client -> User Discovery Register User Function
 func (c *Comms) SendRegisterUser(host *connect.Host, message *pb.UDBUserRegistration) (*messages.Ack, error) {
+	return c.SendRegisterUserWithContext(context.Background(), host, message)
+}
+
+// SendRegisterUserWithContext is SendRegisterUser with a context that can
+// cancel the send and set its deadline.
+func (c *Comms) SendRegisterUserWithContext(ctx context.Context,
+	host *connect.Host, message *pb.UDBUserRegistration) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -44,7 +51,7 @@ func (c *Comms) SendRegisterUser(host *connect.Host, message *pb.UDBUserRegistra
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Delete message: %+v", message)
-	_, err := c.Send(host, f)
+	_, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().UD, f)
 	if err != nil {
 		return nil, err
 	}
@@ -54,10 +61,17 @@ func (c *Comms) SendRegisterUser(host *connect.Host, message *pb.UDBUserRegistra
 
 // Client -> User Discovery Register Fact Function
 func (c *Comms) SendRegisterFact(host *connect.Host, message *pb.FactRegisterRequest) (*pb.FactRegisterResponse, error) {
+	return c.SendRegisterFactWithContext(context.Background(), host, message)
+}
+
+// SendRegisterFactWithContext is SendRegisterFact with a context that can
+// cancel the send and set its deadline.
+func (c *Comms) SendRegisterFactWithContext(ctx context.Context,
+	host *connect.Host, message *pb.FactRegisterRequest) (*pb.FactRegisterResponse, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -79,7 +93,7 @@ func (c *Comms) SendRegisterFact(host *connect.Host, message *pb.FactRegisterReq
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Register Fact message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().UD, f)
 	if err != nil {
 		return nil, err
 	}
@@ -91,10 +105,17 @@ func (c *Comms) SendRegisterFact(host *connect.Host, message *pb.FactRegisterReq
 
 // Client -> User Discovery Delete Fact Function
 func (c *Comms) SendConfirmFact(host *connect.Host, message *pb.FactConfirmRequest) (*messages.Ack, error) {
+	return c.SendConfirmFactWithContext(context.Background(), host, message)
+}
+
+// SendConfirmFactWithContext is SendConfirmFact with a context that can cancel
+// the send and set its deadline.
+func (c *Comms) SendConfirmFactWithContext(ctx context.Context,
+	host *connect.Host, message *pb.FactConfirmRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -116,7 +137,7 @@ func (c *Comms) SendConfirmFact(host *connect.Host, message *pb.FactConfirmReque
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Delete message: %+v", message)
-	_, err := c.Send(host, f)
+	_, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().UD, f)
 	if err != nil {
 		return nil, err
 	}
@@ -126,10 +147,17 @@ func (c *Comms) SendConfirmFact(host *connect.Host, message *pb.FactConfirmReque
 
 // Client -> User Discovery Delete Fact Function
 func (c *Comms) SendRemoveFact(host *connect.Host, message *pb.FactRemovalRequest) (*messages.Ack, error) {
+	return c.SendRemoveFactWithContext(context.Background(), host, message)
+}
+
+// SendRemoveFactWithContext is SendRemoveFact with a context that can cancel
+// the send and set its deadline.
+func (c *Comms) SendRemoveFactWithContext(ctx context.Context,
+	host *connect.Host, message *pb.FactRemovalRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -151,7 +179,7 @@ func (c *Comms) SendRemoveFact(host *connect.Host, message *pb.FactRemovalReques
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Delete Fact Message: %+v", message)
-	_, err := c.Send(host, f)
+	_, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().UD, f)
 	if err != nil {
 		return nil, err
 	}
@@ -161,10 +189,17 @@ func (c *Comms) SendRemoveFact(host *connect.Host, message *pb.FactRemovalReques
 
 // Client -> User Discovery Delete Fact Function
 func (c *Comms) SendRemoveUser(host *connect.Host, message *pb.FactRemovalRequest) (*messages.Ack, error) {
+	return c.SendRemoveUserWithContext(context.Background(), host, message)
+}
+
+// SendRemoveUserWithContext is SendRemoveUser with a context that can cancel
+// the send and set its deadline.
+func (c *Comms) SendRemoveUserWithContext(ctx context.Context,
+	host *connect.Host, message *pb.FactRemovalRequest) (*messages.Ack, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -186,7 +221,7 @@ func (c *Comms) SendRemoveUser(host *connect.Host, message *pb.FactRemovalReques
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Delete Fact Message: %+v", message)
-	_, err := c.Send(host, f)
+	_, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().UD, f)
 	if err != nil {
 		return nil, err
 	}
@@ -196,10 +231,18 @@ func (c *Comms) SendRemoveUser(host *connect.Host, message *pb.FactRemovalReques
 
 // Client -> User Discovery channel authentication & lease request
 func (c *Comms) SendChannelLeaseRequest(host *connect.Host, message *pb.ChannelLeaseRequest) (*pb.ChannelLeaseResponse, error) {
+	return c.SendChannelLeaseRequestWithContext(context.Background(),
+		host, message)
+}
+
+// SendChannelLeaseRequestWithContext is SendChannelLeaseRequest with a context
+// that can cancel the send and set its deadline.
+func (c *Comms) SendChannelLeaseRequestWithContext(ctx context.Context,
+	host *connect.Host, message *pb.ChannelLeaseRequest) (*pb.ChannelLeaseResponse, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
@@ -220,7 +263,7 @@ func (c *Comms) SendChannelLeaseRequest(host *connect.Host, message *pb.ChannelL
 	}
 
 	jww.TRACE.Printf("Sending Channel Lease Request message: %+v", message)
-	resultMsg, err := c.Send(host, f)
+	resultMsg, err := c.sendWithContext(ctx, host, c.GetRetryPolicies().UD, f)
 	if err != nil {
 		return nil, err
 	}
@@ -235,26 +278,33 @@ func (c *Comms) SendChannelLeaseRequest(host *connect.Host, message *pb.ChannelL
 // mixmessages.UsernameValidationRequest to UD, which UD will validate and send back a
 // mixmessages.UsernameValidation.
 func (c *Comms) SendUsernameValidation(host *connect.Host,
+	message *pb.UsernameValidationRequest) (*pb.UsernameValidation, error) {
+	return c.SendUsernameValidationWithContext(context.Background(), host, message)
+}
+
+// SendUsernameValidationWithContext is SendUsernameValidation with a context
+// that can cancel the send and set its deadline.
+func (c *Comms) SendUsernameValidationWithContext(ctx context.Context,
+	host *connect.Host,
 	message *pb.UsernameValidationRequest) (*pb.UsernameValidation, error) {
 	// Create the Send Function
-	f := func(conn connect.Connection) (*any.Any, error) {
+	f := func(ctx context.Context, conn connect.Connection) (*any.Any, error) {
 		// Set up the context
-		ctx, cancel := host.GetMessagingContext()
+		ctx, cancel := getMessagingContext(ctx, host)
 		defer cancel()
 
 		// Send the message
 		resultMsg, err := pb.NewUDBClient(conn.GetGrpcConn()).ValidateUsername(ctx, message)
 		if err != nil {
-			err = errors.New(err.Error())
-			return nil, errors.New(err.Error())
-
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
 	jww.TRACE.Printf("Sending Username Validation Message: %+v", message)
-	responseMessage, err := c.Send(host, f)
+	responseMessage, err := c.sendWithContext(
+		ctx, host, c.GetRetryPolicies().UD, f)
 	if err != nil {
 		return nil, err
 	}
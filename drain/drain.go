@@ -0,0 +1,254 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package drain tracks the RPCs in flight on a gRPC server so that the server
+// can be drained before it is shut down: new RPCs are rejected with a draining
+// error while those already in flight, including streams, are allowed to
+// finish. Subscription streams, which only end when the client closes them,
+// are cancelled instead.
+package drain
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Error messages.
+const (
+	drainingErr     = "server is draining and not accepting new requests"
+	drainTimeoutErr = "timed out draining server with %d requests in flight"
+)
+
+// Tracker counts the RPCs in flight on the services registered through it and
+// rejects new RPCs once draining. The zero value is ready to use.
+type Tracker struct {
+	draining bool
+	inFlight int
+
+	// subscriptions holds the cancel function of each subscription stream in
+	// flight, keyed on a unique ID
+	subscriptions map[uint64]context.CancelFunc
+	nextID        uint64
+
+	// changed is closed and replaced each time an RPC completes while draining
+	changed chan struct{}
+	mux     sync.Mutex
+}
+
+// Registrar returns a grpc.ServiceRegistrar that registers services on the
+// server with each of their methods tracked by the Tracker. Use it in place
+// of the server when calling the generated Register functions.
+//
+// The subscriptions are the full method names (e.g.,
+// "/mixmessages.Gateway/SubscribeRounds") of streams that run until the
+// client closes them. The context of these streams is cancelled when draining
+// starts so that their handlers return.
+func (t *Tracker) Registrar(server *grpc.Server,
+	subscriptions ...string) grpc.ServiceRegistrar {
+	r := &registrar{server: server, t: t,
+		subscriptions: make(map[string]bool, len(subscriptions))}
+	for _, method := range subscriptions {
+		r.subscriptions[method] = true
+	}
+	return r
+}
+
+// Drain rejects all new RPCs, cancels the subscription streams in flight, and
+// waits up to the timeout for all RPCs in flight to complete. If progress is not nil, it is called with the number of RPCs in
+// flight when draining starts and each time one completes. Returns an error if
+// RPCs are still in flight at the timeout. RPCs remain rejected after Drain
+// returns until Resume is called.
+func (t *Tracker) Drain(timeout time.Duration, progress func(inFlight int)) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	t.mux.Lock()
+	t.draining = true
+	for _, cancel := range t.subscriptions {
+		cancel()
+	}
+	for {
+		inFlight, changed := t.inFlight, t.changed
+		if changed == nil {
+			changed = make(chan struct{})
+			t.changed = changed
+		}
+		t.mux.Unlock()
+
+		if progress != nil {
+			progress(inFlight)
+		}
+		if inFlight == 0 {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-deadline.C:
+			return errors.Errorf(drainTimeoutErr, t.InFlight())
+		}
+		t.mux.Lock()
+	}
+}
+
+// Resume accepts new RPCs again after Drain.
+func (t *Tracker) Resume() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.draining = false
+}
+
+// InFlight returns the number of RPCs currently in flight.
+func (t *Tracker) InFlight() int {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.inFlight
+}
+
+// IsDrainingError returns true if the error was returned for an RPC rejected
+// because the server is draining. The request can be sent to another server or
+// retried once the server has restarted.
+func IsDrainingError(err error) bool {
+	return err != nil && status.Code(errors.Cause(err)) == codes.Unavailable &&
+		strings.Contains(err.Error(), drainingErr)
+}
+
+// start records the start of an RPC and returns the function to call when it
+// completes. Returns a draining error if the RPC is rejected.
+func (t *Tracker) start() (func(), error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if t.draining {
+		return nil, status.Error(codes.Unavailable, drainingErr)
+	}
+
+	t.inFlight++
+	return t.done, nil
+}
+
+// startSubscription records the start of a subscription stream and returns
+// a context derived from the stream's that is cancelled when draining starts,
+// along with the function to call when the stream completes. Returns a
+// draining error if the stream is rejected.
+func (t *Tracker) startSubscription(
+	ctx context.Context) (context.Context, func(), error) {
+	done, err := t.start()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	t.mux.Lock()
+	if t.subscriptions == nil {
+		t.subscriptions = make(map[uint64]context.CancelFunc)
+	}
+	subID := t.nextID
+	t.nextID++
+	t.subscriptions[subID] = cancel
+	t.mux.Unlock()
+
+	return ctx, func() {
+		t.mux.Lock()
+		delete(t.subscriptions, subID)
+		t.mux.Unlock()
+		cancel()
+		done()
+	}, nil
+}
+
+// done records the completion of an RPC and notifies a waiting Drain.
+func (t *Tracker) done() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.inFlight--
+	if t.changed != nil {
+		close(t.changed)
+		t.changed = nil
+	}
+}
+
+// registrar registers services with the handler of each method wrapped to be
+// tracked by the Tracker.
+type registrar struct {
+	server        *grpc.Server
+	t             *Tracker
+	subscriptions map[string]bool
+}
+
+// RegisterService registers a copy of the service description whose method
+// and stream handlers are tracked.
+func (r *registrar) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	tracked := *desc
+
+	tracked.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, method := range desc.Methods {
+		handler := method.Handler
+		method.Handler = func(srv interface{}, ctx context.Context,
+			dec func(interface{}) error,
+			interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			done, err := r.t.start()
+			if err != nil {
+				return nil, err
+			}
+			defer done()
+			return handler(srv, ctx, dec, interceptor)
+		}
+		tracked.Methods[i] = method
+	}
+
+	tracked.Streams = make([]grpc.StreamDesc, len(desc.Streams))
+	for i, stream := range desc.Streams {
+		handler := stream.Handler
+		if r.subscriptions["/"+desc.ServiceName+"/"+stream.StreamName] {
+			stream.Handler = r.t.trackSubscription(handler)
+		} else {
+			stream.Handler = func(srv interface{}, ss grpc.ServerStream) error {
+				done, err := r.t.start()
+				if err != nil {
+					return err
+				}
+				defer done()
+				return handler(srv, ss)
+			}
+		}
+		tracked.Streams[i] = stream
+	}
+
+	r.server.RegisterService(&tracked, impl)
+}
+
+// trackSubscription wraps the handler of a subscription stream so that the
+// stream is tracked and its context is cancelled when draining starts.
+func (t *Tracker) trackSubscription(
+	handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, ss grpc.ServerStream) error {
+		ctx, done, err := t.startSubscription(ss.Context())
+		if err != nil {
+			return err
+		}
+		defer done()
+		return handler(srv, &subscriptionStream{ss, ctx})
+	}
+}
+
+// subscriptionStream is a grpc.ServerStream whose context is cancelled when
+// draining starts.
+type subscriptionStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s *subscriptionStream) Context() context.Context {
+	return s.ctx
+}
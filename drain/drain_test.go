@@ -0,0 +1,119 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package drain
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Tests that Drain rejects new requests and waits for those in flight,
+// reporting progress as they complete.
+func TestTracker_Drain(t *testing.T) {
+	var tracker Tracker
+	done1, err := tracker.start()
+	if err != nil {
+		t.Fatalf("Failed to start request: %+v", err)
+	}
+	done2, _ := tracker.start()
+
+	progress := make(chan int, 3)
+	result := make(chan error)
+	go func() {
+		result <- tracker.Drain(5*time.Second, func(n int) { progress <- n })
+	}()
+
+	if n := <-progress; n != 2 {
+		t.Errorf("Unexpected initial progress: %d", n)
+	}
+	if _, err = tracker.start(); !IsDrainingError(err) {
+		t.Errorf("Expected draining error, received: %+v", err)
+	}
+
+	done1()
+	done2()
+	if err = <-result; err != nil {
+		t.Errorf("Drain error: %+v", err)
+	}
+
+	close(progress)
+	var reported []int
+	for n := range progress {
+		reported = append(reported, n)
+	}
+	if last := reported[len(reported)-1]; last != 0 {
+		t.Errorf("Unexpected final progress %v", reported)
+	}
+
+	tracker.Resume()
+	if _, err = tracker.start(); err != nil {
+		t.Errorf("Request rejected after Resume: %+v", err)
+	}
+}
+
+// Error path: Tests that Drain returns an error if requests are still in
+// flight at the timeout.
+func TestTracker_Drain_Timeout(t *testing.T) {
+	var tracker Tracker
+	_, _ = tracker.start()
+
+	var reported []int
+	err := tracker.Drain(10*time.Millisecond, func(n int) {
+		reported = append(reported, n)
+	})
+	if err == nil {
+		t.Errorf("Drain did not time out with a request in flight.")
+	}
+	if !reflect.DeepEqual(reported, []int{1}) {
+		t.Errorf("Unexpected progress: %v", reported)
+	}
+}
+
+// mockServerStream is a grpc.ServerStream with a background context.
+type mockServerStream struct {
+	grpc.ServerStream
+}
+
+func (mockServerStream) Context() context.Context {
+	return context.Background()
+}
+
+// Tests that Drain cancels the context of a subscription stream in flight so
+// that its handler returns, and that new subscriptions are rejected.
+func TestTracker_Drain_Subscription(t *testing.T) {
+	var tracker Tracker
+	started := make(chan struct{})
+	handler := tracker.trackSubscription(
+		func(_ interface{}, ss grpc.ServerStream) error {
+			close(started)
+			<-ss.Context().Done()
+			return nil
+		})
+
+	result := make(chan error)
+	go func() { result <- handler(nil, mockServerStream{}) }()
+	<-started
+
+	if err := tracker.Drain(5*time.Second, nil); err != nil {
+		t.Errorf("Drain error: %+v", err)
+	}
+	if err := <-result; err != nil {
+		t.Errorf("Subscription error: %+v", err)
+	}
+	if len(tracker.subscriptions) != 0 {
+		t.Errorf("Subscription not removed: %v", tracker.subscriptions)
+	}
+
+	err := handler(nil, mockServerStream{})
+	if !IsDrainingError(err) {
+		t.Errorf("Expected draining error, received: %+v", err)
+	}
+}
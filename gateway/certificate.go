@@ -0,0 +1,61 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the replacement of the gateway's HTTPS certificate at runtime
+
+package gateway
+
+import (
+	"crypto/tls"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+)
+
+// Error messages.
+const (
+	invalidHttpsCertErr = "invalid HTTPS certificate and key"
+	serveHttpsErr       = "failed to serve HTTPS with new certificate"
+	httpsServingErr     = "HTTPS is already served with a certificate; " +
+		"call RestartGateway before replacing it"
+)
+
+// UpdateHttpsCertificate serves HTTPS with the PEM encoded certificate and
+// key and returns the certificate, with its signature, from RequestTlsCert
+// from then on without calling the Handler.
+//
+// connect.ProtoComms cannot replace the certificate of a running HTTPS
+// listener, so an error is returned if HTTPS is already served. Call
+// RestartGateway, after Drain, before serving a new certificate.
+func (g *Comms) UpdateHttpsCertificate(certPem, keyPem, signature []byte) error {
+	keyPair, err := tls.X509KeyPair(certPem, keyPem)
+	if err != nil {
+		return errors.Wrap(err, invalidHttpsCertErr)
+	}
+
+	g.httpsMux.Lock()
+	defer g.httpsMux.Unlock()
+
+	if g.httpsServing {
+		return errors.New(httpsServingErr)
+	}
+
+	if err = g.ServeHttps(keyPair); err != nil {
+		return errors.WithMessage(err, serveHttpsErr)
+	}
+	g.httpsServing = true
+
+	g.httpsCert.Store(
+		&pb.GatewayCertificate{Certificate: certPem, Signature: signature})
+	return nil
+}
+
+// getHttpsCertificate returns the certificate set by UpdateHttpsCertificate,
+// or nil if none has been set.
+func (g *Comms) getHttpsCertificate() *pb.GatewayCertificate {
+	cert, _ := g.httpsCert.Load().(*pb.GatewayCertificate)
+	return cert
+}
@@ -0,0 +1,71 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"bytes"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"golang.org/x/net/context"
+	"testing"
+)
+
+// Tests that RequestTlsCert returns the certificate set with
+// UpdateHttpsCertificate as soon as it is set.
+func TestComms_UpdateHttpsCertificate(t *testing.T) {
+	gwAddress := getNextGatewayAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gw := StartGateway(testID, gwAddress, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+
+	certPem := testkeys.LoadFromPath(testkeys.GetGatewayCertPath())
+	keyPem := testkeys.LoadFromPath(testkeys.GetGatewayKeyPath())
+	signature := []byte("signature")
+	err := gw.UpdateHttpsCertificate(certPem, keyPem, signature)
+	if err != nil {
+		t.Fatalf("Failed to update certificate: %+v", err)
+	}
+
+	cert, err := gw.RequestTlsCert(context.Background(), &pb.RequestGatewayCert{})
+	if err != nil || !bytes.Equal(cert.GetCertificate(), certPem) ||
+		!bytes.Equal(cert.GetSignature(), signature) {
+		t.Errorf("Unexpected certificate: %v, %+v", cert, err)
+	}
+
+	// The certificate of a running HTTPS listener cannot be replaced
+	newCertPem := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+	newKeyPem := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	err = gw.UpdateHttpsCertificate(newCertPem, newKeyPem, signature)
+	if err == nil {
+		t.Error("Replaced the certificate of a running HTTPS listener")
+	}
+	cert, err = gw.RequestTlsCert(context.Background(), &pb.RequestGatewayCert{})
+	if err != nil || !bytes.Equal(cert.GetCertificate(), certPem) {
+		t.Errorf("Certificate changed after failed update: %v, %+v", cert, err)
+	}
+}
+
+// Error path: Tests that UpdateHttpsCertificate rejects a key that does not
+// match the certificate.
+func TestComms_UpdateHttpsCertificate_InvalidKey(t *testing.T) {
+	gwAddress := getNextGatewayAddress()
+	testID := id.NewIdFromString("test", id.Gateway, t)
+	gw := StartGateway(testID, gwAddress, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw.Shutdown()
+
+	err := gw.UpdateHttpsCertificate(
+		testkeys.LoadFromPath(testkeys.GetGatewayCertPath()),
+		testkeys.LoadFromPath(testkeys.GetNodeKeyPath()), nil)
+	if err == nil {
+		t.Errorf("Failed to reject mismatched certificate and key.")
+	}
+}
@@ -0,0 +1,142 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"context"
+	"gitlab.com/elixxir/comms/drain"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"io"
+	"testing"
+	"time"
+)
+
+// Tests that Drain lets a request in flight complete while rejecting new
+// requests, and that the gateway accepts requests again after RestartGateway.
+func TestComms_Drain(t *testing.T) {
+	gwAddress1 := getNextGatewayAddress()
+	gwAddress2 := getNextGatewayAddress()
+	testID1 := id.NewIdFromString("test1", id.Gateway, t)
+	testID2 := id.NewIdFromString("test2", id.Gateway, t)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	impl := NewImplementation()
+	impl.Functions.RequestMessages = func(*pb.GetMessages) (*pb.GetMessagesResponse, error) {
+		started <- struct{}{}
+		<-release
+		return &pb.GetMessagesResponse{HasRound: true}, nil
+	}
+
+	gw1 := StartGateway(testID1, gwAddress1, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	gw2 := StartGateway(testID2, gwAddress2, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw1.Shutdown()
+	defer gw2.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID2, gwAddress2, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+
+	// Start a request that blocks in the handler
+	inFlight := make(chan error)
+	go func() {
+		resp, err := gw1.SendRequestMessages(host, &pb.GetMessages{}, time.Minute)
+		if err == nil && !resp.GetHasRound() {
+			t.Errorf("Unexpected response: %v", resp)
+		}
+		inFlight <- err
+	}()
+	<-started
+
+	drained := make(chan error)
+	progress := make(chan int, 10)
+	go func() {
+		drained <- gw2.Drain(time.Minute, func(n int) { progress <- n })
+	}()
+	if n := <-progress; n != 1 {
+		t.Errorf("Unexpected requests in flight: %d", n)
+	}
+
+	_, err = gw1.SendPutMessageProxy(host, &pb.GatewaySlot{}, time.Minute)
+	if !drain.IsDrainingError(err) {
+		t.Errorf("Expected draining error, received: %+v", err)
+	}
+
+	close(release)
+	if err = <-inFlight; err != nil {
+		t.Errorf("Request in flight failed: %+v", err)
+	}
+	if err = <-drained; err != nil {
+		t.Errorf("Drain error: %+v", err)
+	}
+
+	if err = gw2.RestartGateway(); err != nil {
+		t.Fatalf("Failed to restart gateway: %+v", err)
+	}
+	_, err = gw1.SendPutMessageProxy(host, &pb.GatewaySlot{}, time.Minute)
+	if err != nil {
+		t.Errorf("Request failed after restart: %+v", err)
+	}
+}
+
+// Tests that Drain completes while a client holds a round subscription open
+// to the default Implementation, whose update channel is never closed.
+func TestComms_Drain_RoundSubscription(t *testing.T) {
+	gwAddress1 := getNextGatewayAddress()
+	gwAddress2 := getNextGatewayAddress()
+	testID1 := id.NewIdFromString("test1", id.Gateway, t)
+	testID2 := id.NewIdFromString("test2", id.Gateway, t)
+
+	gw1 := StartGateway(testID1, gwAddress1, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	gw2 := StartGateway(testID2, gwAddress2, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw1.Shutdown()
+	defer gw2.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID2, gwAddress2, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resultClient, err := gw1.ProtoComms.Stream(host,
+		func(conn connect.Connection) (interface{}, error) {
+			return pb.NewGatewayClient(conn.GetGrpcConn()).
+				SubscribeRounds(ctx, &pb.RoundSubscription{})
+		})
+	if err != nil {
+		t.Fatalf("Failed to open round subscription: %+v", err)
+	}
+	stream := resultClient.(pb.Gateway_SubscribeRoundsClient)
+
+	// Wait for the gateway to register the subscription
+	if _, err = stream.Header(); err != nil {
+		t.Fatalf("Failed to receive header: %+v", err)
+	}
+
+	if err = gw2.Drain(5*time.Second, nil); err != nil {
+		t.Errorf("Drain error: %+v", err)
+	}
+	if _, err = stream.Recv(); err != io.EOF {
+		t.Errorf("Expected subscription to end, received: %+v", err)
+	}
+}
@@ -10,16 +10,25 @@
 package gateway
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
-	"strconv"
 )
 
+// roundSubscriptionClosedErr is returned to the client when the handler closes
+// a round subscription, e.g. because the client fell too far behind.
+const roundSubscriptionClosedErr = "round subscription closed by gateway"
+
+// roundSubscriptionMethod is the full method name of SubscribeRounds, whose
+// streams are closed when the gateway starts draining.
+const roundSubscriptionMethod = "/mixmessages.Gateway/SubscribeRounds"
+
 // Pass-through for Registration Nonce Communication
 func (g *Comms) RequestClientKey(ctx context.Context,
 	msg *pb.SignedClientKeyRequest) (*pb.SignedKeyResponse, error) {
@@ -50,6 +59,11 @@ func (g *Comms) PutMessage(ctx context.Context, msg *pb.GatewaySlot) (*pb.Gatewa
 		return nil, err
 	}
 
+	// Reject the message if the client is over its rate limits
+	if err = g.getRateLimiter().admit(ctx, 1, msg); err != nil {
+		return nil, err
+	}
+
 	// Upload a message to the cMix Gateway
 	returnMsg, err := g.handler.PutMessage(msg, ipAddr)
 	if err != nil {
@@ -68,6 +82,13 @@ func (g *Comms) PutManyMessages(ctx context.Context, msgs *pb.GatewaySlots) (*pb
 		return nil, err
 	}
 
+	// Reject the messages if the client is over its rate limits
+	err = g.getRateLimiter().admit(
+		ctx, uint32(len(msgs.GetMessages())), msgs.GetMessages()...)
+	if err != nil {
+		return nil, err
+	}
+
 	// Upload messages to the cMix Gateway
 	returnMsg, err := g.handler.PutManyMessages(msgs, ipAddr)
 	if err != nil {
@@ -131,38 +152,61 @@ func (g *Comms) PutManyMessagesProxy(ctx context.Context, msg *messages.Authenti
 
 // Client -> Gateway unified polling
 func (g *Comms) Poll(msg *pb.GatewayPoll, stream pb.Gateway_PollServer) error {
-	// Get response from higher level
-	response, err := g.handler.Poll(msg)
+	// Reject the poll if the client is over its rate limits
+	err := g.getRateLimiter().admitPoll(stream.Context(), msg.GetReceptionID())
 	if err != nil {
 		return err
 	}
 
-	// Split response into streamable chunks
-	chunks, err := pb.SplitResponseIntoChunks(response)
+	// Get response from higher level
+	response, err := g.handler.Poll(msg)
 	if err != nil {
 		return err
 	}
 
-	// Send a header informing client-side of the total number of chunks
-	metadataMap := map[string]string{
-		pb.ChunkHeader: strconv.Itoa(len(chunks)),
+	// Reuse the serialized parts of the response shared between clients
+	if cache := g.getPollCache(); cache != nil {
+		data, err := cache.marshal(msg, response)
+		if err != nil {
+			return err
+		}
+		return streamData(stream, data, "client polling")
 	}
 
-	md := metadata.New(metadataMap)
+	return streamChunks(stream, response, "client polling")
+}
+
+// Client -> Gateway subscription to round updates, streamed until the client
+// closes the stream
+func (g *Comms) SubscribeRounds(msg *pb.RoundSubscription,
+	stream pb.Gateway_SubscribeRoundsServer) error {
+	updates, cancel, err := g.handler.SubscribeRounds(msg)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	// Send a header to confirm to the client that the subscription has been
+	// registered
+	md := metadata.Pairs(pb.RoundSubscriptionHeader, "true")
 	if err = stream.SendHeader(md); err != nil {
 		return errors.Errorf("Failed to send streaming header: %v", err)
 	}
 
-	// Stream each chunk individually
-	for i, chunk := range chunks {
-		err = stream.Send(chunk)
-		if err != nil {
-			return errors.Errorf("Failed to send chunk (%d/%d) for "+
-				"client polling: %v", i, len(chunks), err)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return errors.New(roundSubscriptionClosedErr)
+			}
+			if err = stream.Send(update); err != nil {
+				return errors.Errorf("Failed to send round subscription "+
+					"update: %v", err)
+			}
 		}
 	}
-
-	return nil
 }
 
 // Client -> Gateway historical round request
@@ -172,6 +216,9 @@ func (g *Comms) RequestHistoricalRounds(ctx context.Context, msg *pb.HistoricalR
 
 // Client -> Gateway message request
 func (g *Comms) RequestMessages(ctx context.Context, msg *pb.GetMessages) (*pb.GetMessagesResponse, error) {
+	if err := g.getRateLimiter().admit(ctx, 1); err != nil {
+		return nil, err
+	}
 	return g.handler.RequestMessages(msg)
 }
 
@@ -180,9 +227,98 @@ func (g *Comms) BatchNodeRegistration(ctx context.Context, msg *pb.SignedClientB
 }
 
 func (g *Comms) RequestTlsCert(ctx context.Context, msg *pb.RequestGatewayCert) (*pb.GatewayCertificate, error) {
+	// Return the certificate currently served, if it was set on the gateway
+	if cert := g.getHttpsCertificate(); cert != nil {
+		return cert, nil
+	}
 	return g.handler.RequestTlsCert(msg)
 }
 
 func (g *Comms) RequestBatchMessages(ctx context.Context, msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
+	err := g.getRateLimiter().admit(ctx, uint32(len(msg.GetRequests())))
+	if err != nil {
+		return nil, err
+	}
 	return g.handler.RequestBatchMessages(msg)
 }
+
+// Client -> Gateway message request, with the response streamed in chunks
+func (g *Comms) RequestMessagesStream(msg *pb.GetMessages,
+	stream pb.Gateway_RequestMessagesStreamServer) error {
+	if err := g.getRateLimiter().admit(stream.Context(), 1); err != nil {
+		return err
+	}
+
+	response, err := g.handler.RequestMessages(msg)
+	if err != nil {
+		return err
+	}
+
+	return streamChunks(stream, response, "message request")
+}
+
+// Client -> Gateway batch message request, with the response streamed in
+// chunks
+func (g *Comms) RequestBatchMessagesStream(msg *pb.GetMessagesBatch,
+	stream pb.Gateway_RequestBatchMessagesStreamServer) error {
+	err := g.getRateLimiter().admit(
+		stream.Context(), uint32(len(msg.GetRequests())))
+	if err != nil {
+		return err
+	}
+
+	response, err := g.handler.RequestBatchMessages(msg)
+	if err != nil {
+		return err
+	}
+
+	return streamChunks(stream, response, "batch message request")
+}
+
+// streamChunks splits the response into chunks and streams them, preceded by
+// a header informing the client of the total number of chunks. The response is
+// compressed if the client advertised support for it. The description is used
+// in error messages.
+func streamChunks(stream grpc.ServerStream, response proto.Message,
+	description string) error {
+	data, err := proto.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	return streamData(stream, data, description)
+}
+
+// streamData streams the serialized response in the same way as streamChunks.
+func streamData(stream grpc.ServerStream, data []byte,
+	description string) error {
+	// Split response into streamable chunks, compressed if supported
+	incoming, _ := metadata.FromIncomingContext(stream.Context())
+	compression := pb.NegotiateChunkCompression(incoming)
+	chunks, err := pb.SplitCompressedDataIntoChunks(data, compression)
+	if err != nil {
+		return err
+	}
+
+	// Send a header informing client-side of the total number of chunks, the
+	// digest of their data and the compression used
+	md := pb.NewChunkStreamHeader(chunks)
+	if compression != "" {
+		md.Set(pb.ChunkCompressionHeader, compression)
+	}
+
+	if err = stream.SendHeader(md); err != nil {
+		return errors.Errorf("Failed to send streaming header: %v", err)
+	}
+
+	// Stream each chunk individually
+	for i, chunk := range chunks {
+		err = stream.SendMsg(chunk)
+		if err != nil {
+			return errors.Errorf("Failed to send chunk (%d/%d) for "+
+				"%s: %v", i, len(chunks), description, err)
+		}
+	}
+
+	return nil
+}
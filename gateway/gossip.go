@@ -0,0 +1,307 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains typed gossip protocols for the gateway to gateway gossip of batch
+// senders and recipients
+
+package gateway
+
+import (
+	"crypto/rand"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/crypto/signature/rsa"
+	"gitlab.com/xx_network/primitives/id"
+	"sync"
+)
+
+const (
+	// BatchSendersTag is the gossip tag for pb.BatchSenders
+	BatchSendersTag = "batchSendersGossip"
+
+	// RecipientsTag is the gossip tag for pb.Recipients
+	RecipientsTag = "recipientsGossip"
+
+	// DefaultGossipMaxRounds is the default number of rounds remembered by the
+	// duplicate filter of a typed gossip protocol
+	DefaultGossipMaxRounds = 1000
+)
+
+// Error messages
+const (
+	noGossipProtocolErr = "gossip protocol %s has not been started"
+	noPrivateKeyErr     = "cannot sign gossip: no private key"
+	notTeamMemberErr    = "gossip origin %s is not a team member"
+	noTeamMemberHostErr = "no host with public key for team member %s"
+	badGossipSigErr     = "failed to verify gossip signature from %s: %+v"
+	duplicateGossipErr  = "duplicate gossip from %s for round %d"
+)
+
+// BatchSendersReceiver is called on reception of a verified, non-duplicate
+// pb.BatchSenders from a team member.
+type BatchSendersReceiver func(origin *id.ID, senders *pb.BatchSenders) error
+
+// RecipientsReceiver is called on reception of a verified, non-duplicate
+// pb.Recipients from a team member.
+type RecipientsReceiver func(origin *id.ID, recipients *pb.Recipients) error
+
+// GossipParams contains the parameters for a typed gossip protocol.
+type GossipParams struct {
+	// Flags passed to the underlying gossip.Protocol
+	Flags gossip.ProtocolFlags
+
+	// Number of rounds the duplicate filter remembers. Once exceeded, the
+	// oldest round is forgotten.
+	MaxRounds int
+}
+
+// DefaultGossipParams returns the default GossipParams.
+func DefaultGossipParams() GossipParams {
+	return GossipParams{
+		Flags:     gossip.DefaultProtocolFlags(),
+		MaxRounds: DefaultGossipMaxRounds,
+	}
+}
+
+// StartBatchSendersGossip creates the gossip protocol for pb.BatchSenders with
+// the given team members as peers. Messages are passed to the receiver once
+// their signature has been verified against the origin's key and the origin has
+// not already gossiped senders for the round. Only messages from the team
+// members given here are accepted; to change teams, delete the protocol with
+// Manager.Delete and start it again.
+func (g *Comms) StartBatchSendersGossip(teamMembers []*id.ID,
+	params GossipParams, receiver BatchSendersReceiver) {
+	g.startTypedGossip(BatchSendersTag, teamMembers, params,
+		func(origin *id.ID, payload []byte) (id.Round, func() error, error) {
+			senders := &pb.BatchSenders{}
+			if err := proto.Unmarshal(payload, senders); err != nil {
+				return 0, nil, err
+			}
+			return id.Round(senders.RoundID), func() error {
+				return receiver(origin, senders)
+			}, nil
+		})
+}
+
+// StartRecipientsGossip creates the gossip protocol for pb.Recipients with the
+// given team members as peers. Messages are passed to the receiver once their
+// signature has been verified against the origin's key and the origin has not
+// already gossiped recipients for the round.
+func (g *Comms) StartRecipientsGossip(teamMembers []*id.ID,
+	params GossipParams, receiver RecipientsReceiver) {
+	g.startTypedGossip(RecipientsTag, teamMembers, params,
+		func(origin *id.ID, payload []byte) (id.Round, func() error, error) {
+			recipients := &pb.Recipients{}
+			if err := proto.Unmarshal(payload, recipients); err != nil {
+				return 0, nil, err
+			}
+			return id.Round(recipients.RoundID), func() error {
+				return receiver(origin, recipients)
+			}, nil
+		})
+}
+
+// PublishBatchSenders signs and gossips the senders to all team members. It
+// returns the number of peers gossiped to.
+func (g *Comms) PublishBatchSenders(senders *pb.BatchSenders) (int, error) {
+	return g.publishTypedGossip(BatchSendersTag, senders)
+}
+
+// PublishRecipients signs and gossips the recipients to all team members. It
+// returns the number of peers gossiped to.
+func (g *Comms) PublishRecipients(recipients *pb.Recipients) (int, error) {
+	return g.publishTypedGossip(RecipientsTag, recipients)
+}
+
+// typedDecoder unmarshals a gossip payload and returns its round and a function
+// that passes the message to the typed receiver.
+type typedDecoder func(origin *id.ID, payload []byte) (
+	round id.Round, receive func() error, err error)
+
+// startTypedGossip creates the gossip protocol for the tag, wiring in signature
+// verification and the per round duplicate filter.
+func (g *Comms) startTypedGossip(tag string, teamMembers []*id.ID,
+	params GossipParams, decode typedDecoder) {
+	filter := newRoundFilter(params.MaxRounds)
+
+	team := make(map[id.ID]struct{}, len(teamMembers))
+	for _, member := range teamMembers {
+		team[*member] = struct{}{}
+	}
+
+	verifier := func(msg *gossip.GossipMsg, _ []byte) error {
+		return g.verifyGossip(team, msg)
+	}
+
+	receiver := func(msg *gossip.GossipMsg) error {
+		origin, err := id.Unmarshal(msg.Origin)
+		if err != nil {
+			return err
+		}
+
+		round, receive, err := decode(origin, msg.Payload)
+		if err != nil {
+			return errors.WithMessagef(err,
+				"failed to unmarshal %s payload from %s", tag, origin)
+		}
+
+		if !filter.add(round, origin) {
+			return errors.Errorf(duplicateGossipErr, origin, round)
+		}
+
+		return receive()
+	}
+
+	g.Manager.NewGossip(tag, params.Flags, receiver, verifier, teamMembers)
+}
+
+// publishTypedGossip marshals and signs the message and gossips it under the
+// tag.
+func (g *Comms) publishTypedGossip(tag string, msg proto.Message) (int, error) {
+	protocol, exists := g.Manager.Get(tag)
+	if !exists {
+		return 0, errors.Errorf(noGossipProtocolErr, tag)
+	}
+
+	gossipMsg, err := g.newTypedGossipMsg(tag, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	numPeers, errs := protocol.Gossip(gossipMsg)
+	if len(errs) != 0 {
+		jww.WARN.Printf("Failed to gossip %s to %d of %d peers: %v",
+			tag, len(errs), numPeers, errs)
+		if len(errs) == numPeers {
+			return numPeers, errors.Errorf(
+				"failed to gossip %s to all %d peers: %v", tag, numPeers, errs)
+		}
+	}
+
+	return numPeers, nil
+}
+
+// newTypedGossipMsg builds a gossip message for the tag with the marshalled
+// message as its payload, signed by the gateway.
+func (g *Comms) newTypedGossipMsg(tag string, msg proto.Message) (
+	*gossip.GossipMsg, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	gossipMsg := &gossip.GossipMsg{
+		Tag:     tag,
+		Origin:  g.GetId().Bytes(),
+		Payload: payload,
+	}
+	gossipMsg.Signature, err = g.signGossip(gossipMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return gossipMsg, nil
+}
+
+// signGossip signs the gossip message with the gateway's private key.
+func (g *Comms) signGossip(msg *gossip.GossipMsg) ([]byte, error) {
+	key := g.GetPrivateKey()
+	if key == nil {
+		return nil, errors.New(noPrivateKeyErr)
+	}
+
+	options := rsa.NewDefaultOptions()
+	signature, err := rsa.Sign(
+		rand.Reader, key, options.Hash, hashGossip(msg, options), nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return signature, nil
+}
+
+// verifyGossip checks that the origin of the message is a team member and that
+// the message was signed with the origin's key.
+func (g *Comms) verifyGossip(team map[id.ID]struct{}, msg *gossip.GossipMsg) error {
+	origin, err := id.Unmarshal(msg.Origin)
+	if err != nil {
+		return errors.WithMessage(err, "failed to unmarshal gossip origin")
+	}
+
+	if _, isMember := team[*origin]; !isMember {
+		return errors.Errorf(notTeamMemberErr, origin)
+	}
+
+	host, exists := g.GetHost(origin)
+	if !exists || host.GetPubKey() == nil {
+		return errors.Errorf(noTeamMemberHostErr, origin)
+	}
+
+	options := rsa.NewDefaultOptions()
+	err = rsa.Verify(host.GetPubKey(), options.Hash, hashGossip(msg, options),
+		msg.Signature, nil)
+	if err != nil {
+		return errors.Errorf(badGossipSigErr, origin, err)
+	}
+
+	return nil
+}
+
+// hashGossip returns the hash of the signed data in the gossip message.
+func hashGossip(msg *gossip.GossipMsg, options *rsa.Options) []byte {
+	h := options.Hash.New()
+	h.Write(gossip.Marshal(msg))
+	return h.Sum(nil)
+}
+
+// roundFilter records which origins have gossiped for each round, forgetting
+// the oldest round once more than maxRounds are recorded.
+type roundFilter struct {
+	maxRounds int
+	rounds    map[id.Round]map[id.ID]struct{}
+	order     []id.Round
+	mux       sync.Mutex
+}
+
+// newRoundFilter creates an empty roundFilter.
+func newRoundFilter(maxRounds int) *roundFilter {
+	if maxRounds <= 0 {
+		maxRounds = DefaultGossipMaxRounds
+	}
+	return &roundFilter{
+		maxRounds: maxRounds,
+		rounds:    make(map[id.Round]map[id.ID]struct{}),
+	}
+}
+
+// add records the origin for the round. Returns false if the origin has already
+// been recorded for the round.
+func (rf *roundFilter) add(round id.Round, origin *id.ID) bool {
+	rf.mux.Lock()
+	defer rf.mux.Unlock()
+
+	origins, exists := rf.rounds[round]
+	if !exists {
+		if len(rf.order) >= rf.maxRounds {
+			delete(rf.rounds, rf.order[0])
+			rf.order = rf.order[1:]
+		}
+		origins = make(map[id.ID]struct{})
+		rf.rounds[round] = origins
+		rf.order = append(rf.order, round)
+	}
+
+	if _, exists = origins[*origin]; exists {
+		return false
+	}
+	origins[*origin] = struct{}{}
+
+	return true
+}
@@ -0,0 +1,166 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"context"
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startGossipTeam starts two gateways with keys where the second has a host
+// for the first.
+func startGossipTeam(t *testing.T) (*Comms, *Comms) {
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+
+	addrA, addrB := getNextGatewayAddress(), getNextGatewayAddress()
+	idA := id.NewIdFromString("gatewayA", id.Gateway, t)
+	idB := id.NewIdFromString("gatewayB", id.Gateway, t)
+	gwA := StartGateway(idA, addrA, NewImplementation(), certData, keyData,
+		gossip.DefaultManagerFlags())
+	gwB := StartGateway(idB, addrB, NewImplementation(), certData, keyData,
+		gossip.DefaultManagerFlags())
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	if _, err := gwB.AddHost(idA, addrA, certData, params); err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	return gwA, gwB
+}
+
+// Tests that batch senders signed by one team member are verified and passed
+// to the receiver of another.
+func TestComms_StartBatchSendersGossip(t *testing.T) {
+	gwA, gwB := startGossipTeam(t)
+	defer gwA.Shutdown()
+	defer gwB.Shutdown()
+
+	received := make(chan *pb.BatchSenders, 1)
+	gwB.StartBatchSendersGossip([]*id.ID{gwA.GetId()}, DefaultGossipParams(),
+		func(origin *id.ID, senders *pb.BatchSenders) error {
+			if !origin.Cmp(gwA.GetId()) {
+				t.Errorf("Unexpected origin %s", origin)
+			}
+			received <- senders
+			return nil
+		})
+
+	senders := &pb.BatchSenders{
+		SenderIds: [][]byte{[]byte("sender")},
+		RoundID:   42,
+		Ips:       [][]byte{[]byte("0.0.0.0")},
+	}
+	msg, err := gwA.newTypedGossipMsg(BatchSendersTag, senders)
+	if err != nil {
+		t.Fatalf("Failed to create gossip: %+v", err)
+	}
+	if _, err = gwB.Manager.Endpoint(context.Background(), msg); err != nil {
+		t.Fatalf("Endpoint error: %+v", err)
+	}
+
+	select {
+	case r := <-received:
+		if !proto.Equal(senders, r) {
+			t.Errorf("Unexpected senders.\nexpected: %v\nreceived: %v",
+				senders, r)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for batch senders.")
+	}
+}
+
+// Tests that the typed receiver rejects gossip from non-team members, gossip
+// with a bad signature and duplicate gossip for a round.
+func TestComms_StartRecipientsGossip_Rejected(t *testing.T) {
+	gwA, gwB := startGossipTeam(t)
+	defer gwA.Shutdown()
+	defer gwB.Shutdown()
+
+	var numReceived uint32
+	gwB.StartRecipientsGossip([]*id.ID{gwA.GetId()}, DefaultGossipParams(),
+		func(*id.ID, *pb.Recipients) error {
+			atomic.AddUint32(&numReceived, 1)
+			return nil
+		})
+
+	send := func(recipients *pb.Recipients) {
+		msg, err := gwA.newTypedGossipMsg(RecipientsTag, recipients)
+		if err != nil {
+			t.Fatalf("Failed to create gossip: %+v", err)
+		}
+		if _, err = gwB.Manager.Endpoint(context.Background(), msg); err != nil {
+			t.Fatalf("Endpoint error: %+v", err)
+		}
+	}
+
+	// The second is a duplicate for round 1 with different content
+	send(&pb.Recipients{RoundID: 1})
+	send(&pb.Recipients{RoundID: 1, RoundTS: 5})
+
+	msg, err := gwA.newTypedGossipMsg(RecipientsTag, &pb.Recipients{RoundID: 2})
+	if err != nil {
+		t.Fatalf("Failed to create gossip: %+v", err)
+	}
+	if err = gwB.verifyGossip(map[id.ID]struct{}{}, msg); err == nil {
+		t.Errorf("Gossip from non-team member not rejected.")
+	}
+
+	msg.Payload = []byte("modified")
+	team := map[id.ID]struct{}{*gwA.GetId(): {}}
+	if err = gwB.verifyGossip(team, msg); err == nil {
+		t.Errorf("Gossip with bad signature not rejected.")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if n := atomic.LoadUint32(&numReceived); n != 1 {
+		t.Errorf("Unexpected number of messages received: %d", n)
+	}
+}
+
+// Tests that publishing fails when the protocol has not been started or no
+// team member can be reached.
+func TestComms_PublishRecipients_Error(t *testing.T) {
+	gwA, gwB := startGossipTeam(t)
+	defer gwA.Shutdown()
+	defer gwB.Shutdown()
+
+	if _, err := gwA.PublishRecipients(&pb.Recipients{}); err == nil {
+		t.Errorf("Publishing without a protocol did not fail.")
+	}
+
+	gwA.StartRecipientsGossip([]*id.ID{gwB.GetId()}, DefaultGossipParams(),
+		func(*id.ID, *pb.Recipients) error { return nil })
+	if _, err := gwA.PublishRecipients(&pb.Recipients{}); err == nil {
+		t.Errorf("Publishing to unknown team member did not fail.")
+	}
+}
+
+// Tests that roundFilter rejects duplicates and forgets the oldest round.
+func TestRoundFilter_add(t *testing.T) {
+	rf := newRoundFilter(2)
+	origin := id.NewIdFromString("origin", id.Gateway, t)
+
+	if !rf.add(1, origin) || rf.add(1, origin) {
+		t.Errorf("Duplicate not detected.")
+	}
+	rf.add(2, origin)
+	rf.add(3, origin)
+	if !rf.add(1, origin) {
+		t.Errorf("Oldest round not forgotten.")
+	}
+}
@@ -13,12 +13,16 @@ package gateway
 
 import (
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/drain"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/gossip"
 	"gitlab.com/xx_network/comms/messages"
 	"gitlab.com/xx_network/primitives/id"
 	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Comms object bundles low-level connect.ProtoComms,
@@ -29,6 +33,33 @@ type Comms struct {
 	handler Handler
 	*pb.UnimplementedGatewayServer
 	*messages.UnimplementedGenericServer
+
+	// rateLimits holds the *rateLimiter set by SetRateLimits
+	rateLimits atomic.Value
+
+	// pollCache holds the *pollCache set by EnablePollCache
+	pollCache atomic.Value
+
+	// drainer tracks requests in flight so the gateway can be drained
+	drainer drain.Tracker
+
+	// httpsCert holds the *pb.GatewayCertificate set by
+	// UpdateHttpsCertificate, returned by RequestTlsCert
+	httpsCert    atomic.Value
+	httpsServing bool
+	httpsMux     sync.Mutex
+}
+
+// Drain stops the gateway from accepting new requests and waits up to the
+// timeout for requests in flight, including streams, to complete. Round
+// subscriptions are closed when draining starts. New requests are rejected
+// with an Unavailable error for which drain.IsDrainingError returns true. If
+// progress is not nil, it is called with the number of requests in flight when
+// draining starts and each time one completes. Returns an error if requests
+// are still in flight at the timeout. Intended for use before RestartGateway
+// or Shutdown.
+func (g *Comms) Drain(timeout time.Duration, progress func(inFlight int)) error {
+	return g.drainer.Drain(timeout, progress)
 }
 
 // Handler describes the endpoint callbacks for Gateway.
@@ -39,11 +70,26 @@ type Handler interface {
 	PutManyMessagesProxy(msgs *pb.GatewaySlots, auth *connect.Auth) (*pb.GatewaySlotResponse, error)
 	Poll(msg *pb.GatewayPoll) (*pb.GatewayPollResponse, error)
 	RequestHistoricalRounds(msg *pb.HistoricalRounds) (*pb.HistoricalRoundsResponse, error)
+
+	// RequestMessages returns the client's messages in the round. At most a
+	// page of messages is returned, along with a continuation token for the
+	// rest; see pb.NewMessagesPage.
 	RequestMessages(msg *pb.GetMessages) (*pb.GetMessagesResponse, error)
+
 	RequestClientKey(message *pb.SignedClientKeyRequest) (*pb.SignedKeyResponse, error)
 	RequestTlsCert(message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error)
 	BatchNodeRegistration(msg *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error)
 	RequestBatchMessages(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error)
+
+	// SubscribeRounds returns a channel of updates to stream to the client
+	// and a function called when the stream ends, typically the return values
+	// of network.Instance.SubscribeRounds. The stream is closed with an error
+	// if the channel is closed.
+	SubscribeRounds(msg *pb.RoundSubscription) (<-chan *pb.RoundSubscriptionUpdate, func(), error)
+
+	// ShareRoundMessages receives the messages of a completed round from
+	// another gateway in the team, to be served to clients via RequestMessages.
+	ShareRoundMessages(msgs *pb.RoundMessages, auth *connect.Auth) error
 }
 
 // StartGateway starts a new gateway on the address:port specified by localServer
@@ -65,7 +111,8 @@ func StartGateway(id *id.ID, localServer string, handler Handler,
 	}
 
 	// Register the high-level comms endpoint functionality
-	grpcServer := gatewayServer.GetServer()
+	grpcServer := gatewayServer.drainer.Registrar(gatewayServer.GetServer(),
+		roundSubscriptionMethod)
 	pb.RegisterGatewayServer(grpcServer, &gatewayServer)
 	messages.RegisterGenericServer(grpcServer, &gatewayServer)
 	gossip.RegisterGossipServer(grpcServer, gatewayServer.Manager)
@@ -76,15 +123,23 @@ func StartGateway(id *id.ID, localServer string, handler Handler,
 
 // RestartGateway shuts down &restarts the underlying protocomms server,
 // re-registers grpc handlers & starts basic listeners again.  Intended for use
-// before replacing https certificates
+// before replacing https certificates with UpdateHttpsCertificate. Call Drain
+// first to let requests in flight complete; the restarted gateway accepts new
+// requests.
 func (g *Comms) RestartGateway() error {
+	g.httpsMux.Lock()
+	defer g.httpsMux.Unlock()
+
 	g.ProtoComms.Shutdown()
+	g.httpsServing = false
 	err := g.ProtoComms.Restart()
 	if err != nil {
 		return err
 	}
+	g.drainer.Resume()
+
 	// Register the high-level comms endpoint functionality
-	grpcServer := g.GetServer()
+	grpcServer := g.drainer.Registrar(g.GetServer(), roundSubscriptionMethod)
 	pb.RegisterGatewayServer(grpcServer, g)
 	messages.RegisterGenericServer(grpcServer, g)
 	gossip.RegisterGossipServer(grpcServer, g.Manager)
@@ -106,6 +161,8 @@ type implementationFunctions struct {
 	RequestTlsCert          func(message *pb.RequestGatewayCert) (*pb.GatewayCertificate, error)
 	BatchNodeRegistration   func(msg *pb.SignedClientBatchKeyRequest) (*pb.SignedBatchKeyResponse, error)
 	RequestBatchMessages    func(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error)
+	SubscribeRounds         func(msg *pb.RoundSubscription) (<-chan *pb.RoundSubscriptionUpdate, func(), error)
+	ShareRoundMessages      func(msgs *pb.RoundMessages, auth *connect.Auth) error
 }
 
 // Implementation allows users of the client library to set the
@@ -167,6 +224,14 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return &pb.GetMessagesResponseBatch{}, nil
 			},
+			SubscribeRounds: func(msg *pb.RoundSubscription) (<-chan *pb.RoundSubscriptionUpdate, func(), error) {
+				warn(um)
+				return make(chan *pb.RoundSubscriptionUpdate), func() {}, nil
+			},
+			ShareRoundMessages: func(msgs *pb.RoundMessages, auth *connect.Auth) error {
+				warn(um)
+				return nil
+			},
 		},
 	}
 }
@@ -182,7 +247,9 @@ func (s *Implementation) PutMessage(message *pb.GatewaySlot, ipAddr string) (*pb
 	return s.Functions.PutMessage(message, ipAddr)
 }
 
-// PutManyMessages uploads many messages to the cMix Gateway.
+// PutManyMessages uploads many messages to the cMix Gateway. The response
+// should contain the result of each slot, as built by
+// pb.NewGatewaySlotResponse, so the client only resends rejected slots.
 func (s *Implementation) PutManyMessages(msgs *pb.GatewaySlots, ipAddr string) (*pb.GatewaySlotResponse, error) {
 	return s.Functions.PutManyMessages(msgs, ipAddr)
 }
@@ -193,6 +260,7 @@ func (s *Implementation) PutMessageProxy(message *pb.GatewaySlot, auth *connect.
 }
 
 // PutManyMessagesProxy uploads many messages to the cMix Gateway from a proxy gateway.
+// The response should contain the result of each slot, as for PutManyMessages.
 func (s *Implementation) PutManyMessagesProxy(msgs *pb.GatewaySlots, auth *connect.Auth) (*pb.GatewaySlotResponse, error) {
 	return s.Functions.PutManyMessagesProxy(msgs, auth)
 }
@@ -223,3 +291,13 @@ func (s *Implementation) BatchNodeRegistration(msg *pb.SignedClientBatchKeyReque
 func (s *Implementation) RequestBatchMessages(msg *pb.GetMessagesBatch) (*pb.GetMessagesResponseBatch, error) {
 	return s.Functions.RequestBatchMessages(msg)
 }
+
+// SubscribeRounds handles Client -> Gateway subscriptions to round updates.
+func (s *Implementation) SubscribeRounds(msg *pb.RoundSubscription) (<-chan *pb.RoundSubscriptionUpdate, func(), error) {
+	return s.Functions.SubscribeRounds(msg)
+}
+
+// ShareRoundMessages handles Gateway -> Gateway replication of round messages.
+func (s *Implementation) ShareRoundMessages(msgs *pb.RoundMessages, auth *connect.Auth) error {
+	return s.Functions.ShareRoundMessages(msgs, auth)
+}
@@ -0,0 +1,165 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the middleware wrapper for the Gateway Handler
+
+package gateway
+
+import (
+	"gitlab.com/elixxir/comms/middleware"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// WithMiddleware returns a Handler that calls each method of the handler
+// through the middleware chain. The endpoint name of each call is the name of
+// the Handler method.
+func WithMiddleware(handler Handler, chain middleware.Chain) Handler {
+	return &middlewareHandler{handler: handler, chain: chain}
+}
+
+// middlewareHandler wraps a Handler with a middleware.Chain.
+type middlewareHandler struct {
+	handler Handler
+	chain   middleware.Chain
+}
+
+func (m *middlewareHandler) PutMessage(message *pb.GatewaySlot,
+	ipAddr string) (response *pb.GatewaySlotResponse, err error) {
+	call := &middleware.Call{
+		Endpoint: "PutMessage", Request: message, IpAddr: ipAddr}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.PutMessage(message, ipAddr)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) PutManyMessages(msgs *pb.GatewaySlots,
+	ipAddr string) (response *pb.GatewaySlotResponse, err error) {
+	call := &middleware.Call{
+		Endpoint: "PutManyMessages", Request: msgs, IpAddr: ipAddr}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.PutManyMessages(msgs, ipAddr)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) PutMessageProxy(message *pb.GatewaySlot,
+	auth *connect.Auth) (response *pb.GatewaySlotResponse, err error) {
+	call := &middleware.Call{
+		Endpoint: "PutMessageProxy", Request: message, Auth: auth}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.PutMessageProxy(message, auth)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) PutManyMessagesProxy(msgs *pb.GatewaySlots,
+	auth *connect.Auth) (response *pb.GatewaySlotResponse, err error) {
+	call := &middleware.Call{
+		Endpoint: "PutManyMessagesProxy", Request: msgs, Auth: auth}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.PutManyMessagesProxy(msgs, auth)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) Poll(msg *pb.GatewayPoll) (
+	response *pb.GatewayPollResponse, err error) {
+	call := &middleware.Call{Endpoint: "Poll", Request: msg}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.Poll(msg)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) RequestHistoricalRounds(msg *pb.HistoricalRounds) (
+	response *pb.HistoricalRoundsResponse, err error) {
+	call := &middleware.Call{Endpoint: "RequestHistoricalRounds", Request: msg}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.RequestHistoricalRounds(msg)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) RequestMessages(msg *pb.GetMessages) (
+	response *pb.GetMessagesResponse, err error) {
+	call := &middleware.Call{Endpoint: "RequestMessages", Request: msg}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.RequestMessages(msg)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) RequestClientKey(message *pb.SignedClientKeyRequest) (
+	response *pb.SignedKeyResponse, err error) {
+	call := &middleware.Call{Endpoint: "RequestClientKey", Request: message}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.RequestClientKey(message)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) RequestTlsCert(message *pb.RequestGatewayCert) (
+	response *pb.GatewayCertificate, err error) {
+	call := &middleware.Call{Endpoint: "RequestTlsCert", Request: message}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.RequestTlsCert(message)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) BatchNodeRegistration(
+	msg *pb.SignedClientBatchKeyRequest) (
+	response *pb.SignedBatchKeyResponse, err error) {
+	call := &middleware.Call{Endpoint: "BatchNodeRegistration", Request: msg}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.BatchNodeRegistration(msg)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) RequestBatchMessages(msg *pb.GetMessagesBatch) (
+	response *pb.GetMessagesResponseBatch, err error) {
+	call := &middleware.Call{Endpoint: "RequestBatchMessages", Request: msg}
+	err = m.chain.Invoke(call, func() error {
+		response, err = m.handler.RequestBatchMessages(msg)
+		return err
+	})
+	return response, err
+}
+
+// SubscribeRounds calls the middleware for opening the subscription; the
+// latency seen by After hooks does not include the lifetime of the stream.
+func (m *middlewareHandler) SubscribeRounds(msg *pb.RoundSubscription) (
+	updates <-chan *pb.RoundSubscriptionUpdate, closeFn func(), err error) {
+	call := &middleware.Call{Endpoint: "SubscribeRounds", Request: msg}
+	err = m.chain.Invoke(call, func() error {
+		updates, closeFn, err = m.handler.SubscribeRounds(msg)
+		return err
+	})
+	return updates, closeFn, err
+}
+
+func (m *middlewareHandler) ShareRoundMessages(msgs *pb.RoundMessages,
+	auth *connect.Auth) error {
+	call := &middleware.Call{
+		Endpoint: "ShareRoundMessages", Request: msgs, Auth: auth}
+	return m.chain.Invoke(call, func() error {
+		return m.handler.ShareRoundMessages(msgs, auth)
+	})
+}
@@ -0,0 +1,88 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/middleware"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Tests that calls to a Handler wrapped with WithMiddleware pass through the
+// hooks with the endpoint name and auth state, and that a Before hook can
+// reject a call before it reaches the handler.
+func TestWithMiddleware(t *testing.T) {
+	gwAddress1 := getNextGatewayAddress()
+	gwAddress2 := getNextGatewayAddress()
+	testID1 := id.NewIdFromString("test1", id.Gateway, t)
+	testID2 := id.NewIdFromString("test2", id.Gateway, t)
+
+	impl := NewImplementation()
+	handled := 0
+	impl.Functions.PutMessageProxy = func(message *pb.GatewaySlot, auth *connect.Auth) (*pb.GatewaySlotResponse, error) {
+		handled++
+		return &pb.GatewaySlotResponse{Accepted: true}, nil
+	}
+
+	var before, after []*middleware.Call
+	reject := false
+	chain := middleware.NewChain(middleware.Middleware{
+		Before: func(call *middleware.Call) error {
+			before = append(before, call)
+			if reject {
+				return errors.New("rejected by middleware")
+			}
+			return nil
+		},
+		After: func(call *middleware.Call, latency time.Duration, err error) {
+			after = append(after, call)
+		},
+	})
+
+	gw1 := StartGateway(testID1, gwAddress1, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	gw2 := StartGateway(testID2, gwAddress2, WithMiddleware(impl, chain), nil,
+		nil, gossip.DefaultManagerFlags())
+	defer gw1.Shutdown()
+	defer gw2.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID1, gwAddress2, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+
+	resp, err := gw1.SendPutMessageProxy(host, &pb.GatewaySlot{}, 2*time.Minute)
+	if err != nil || !resp.GetAccepted() {
+		t.Fatalf("SendPutMessageProxy failed: %v, %+v", resp, err)
+	}
+	if len(before) != 1 || len(after) != 1 || handled != 1 {
+		t.Fatalf("Expected one call to each hook and the handler, received "+
+			"%d, %d and %d.", len(before), len(after), handled)
+	}
+	if before[0].Endpoint != "PutMessageProxy" || before[0].Auth == nil {
+		t.Errorf("Unexpected call: %+v", before[0])
+	}
+
+	reject = true
+	_, err = gw1.SendPutMessageProxy(host, &pb.GatewaySlot{}, 2*time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "rejected by middleware") {
+		t.Errorf("Expected call to be rejected, received: %+v", err)
+	}
+	if handled != 1 {
+		t.Errorf("Rejected call reached the handler.")
+	}
+}
@@ -0,0 +1,158 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the cache of the serialized parts of Poll responses shared between
+// clients
+
+package gateway
+
+import (
+	"bytes"
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"sync"
+)
+
+// EnablePollCache caches the serialized NDF and round updates of Poll
+// responses for up to maxEntries client views, identified by the last update,
+// NDF hash and fast polling flag of the poll. Clients with the same view share
+// the serialized parts, which are combined with the serialized per client
+// parts of each response. A cached entry is only reused while the handler
+// returns the same NDF and updates for the view. A maxEntries of zero or less
+// disables the cache.
+func (g *Comms) EnablePollCache(maxEntries int) {
+	var cache *pollCache
+	if maxEntries > 0 {
+		cache = newPollCache(maxEntries)
+	}
+	g.pollCache.Store(cache)
+}
+
+// getPollCache returns the Poll cache, or nil if it is disabled.
+func (g *Comms) getPollCache() *pollCache {
+	cache, _ := g.pollCache.Load().(*pollCache)
+	return cache
+}
+
+// pollCacheKey identifies the view of the network held by a polling client.
+type pollCacheKey struct {
+	lastUpdate  uint64
+	ndfHash     string
+	fastPolling bool
+}
+
+// pollCacheEntry contains the serialized NDF and updates sent to a view, along
+// with what is needed to check that a response contains the same NDF and
+// updates.
+type pollCacheEntry struct {
+	ndf         []byte
+	numUpdates  int
+	firstUpdate uint64
+	lastUpdate  uint64
+	data        []byte
+}
+
+// pollCache is a bounded cache of serialized Poll response parts. When full,
+// the oldest entry is evicted.
+type pollCache struct {
+	entries    map[pollCacheKey]*pollCacheEntry
+	order      []pollCacheKey
+	maxEntries int
+	mux        sync.Mutex
+}
+
+// newPollCache returns an empty cache holding up to maxEntries entries.
+func newPollCache(maxEntries int) *pollCache {
+	return &pollCache{
+		entries:    make(map[pollCacheKey]*pollCacheEntry, maxEntries),
+		order:      make([]pollCacheKey, 0, maxEntries),
+		maxEntries: maxEntries,
+	}
+}
+
+// marshal returns the serialized response to the poll. The NDF and updates are
+// taken from the cache if they match those of the response.
+func (pc *pollCache) marshal(msg *pb.GatewayPoll,
+	response *pb.GatewayPollResponse) ([]byte, error) {
+	shared, err := pc.sharedPart(pollCacheKey{
+		lastUpdate:  msg.GetLastUpdate(),
+		ndfHash:     string(msg.GetPartial().GetHash()),
+		fastPolling: msg.GetFastPolling(),
+	}, response)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fields of a message serialized separately can be concatenated
+	perClient, err := proto.Marshal(&pb.GatewayPollResponse{
+		KnownRounds:      response.GetKnownRounds(),
+		Filters:          response.GetFilters(),
+		EarliestRound:    response.GetEarliestRound(),
+		EarliestRoundErr: response.GetEarliestRoundErr(),
+		ReceivedTs:       response.GetReceivedTs(),
+		GatewayDelay:     response.GetGatewayDelay(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, len(shared)+len(perClient))
+	return append(append(data, shared...), perClient...), nil
+}
+
+// sharedPart returns the serialized NDF and updates of the response, from the
+// cache if the entry for the key matches the response.
+func (pc *pollCache) sharedPart(key pollCacheKey,
+	response *pb.GatewayPollResponse) ([]byte, error) {
+	updates := response.GetUpdates()
+	entry := &pollCacheEntry{
+		ndf:        response.GetPartialNDF().GetNdf(),
+		numUpdates: len(updates),
+	}
+	if len(updates) > 0 {
+		entry.firstUpdate = updates[0].GetUpdateID()
+		entry.lastUpdate = updates[len(updates)-1].GetUpdateID()
+	}
+
+	pc.mux.Lock()
+	cached, exists := pc.entries[key]
+	pc.mux.Unlock()
+	if exists && cached.matches(entry) {
+		return cached.data, nil
+	}
+
+	data, err := proto.Marshal(&pb.GatewayPollResponse{
+		PartialNDF: response.GetPartialNDF(),
+		Updates:    updates,
+	})
+	if err != nil {
+		return nil, err
+	}
+	entry.data = data
+
+	pc.mux.Lock()
+	defer pc.mux.Unlock()
+	if _, exists = pc.entries[key]; !exists {
+		if len(pc.order) == pc.maxEntries {
+			delete(pc.entries, pc.order[0])
+			pc.order = pc.order[1:]
+		}
+		pc.order = append(pc.order, key)
+	}
+	pc.entries[key] = entry
+
+	return data, nil
+}
+
+// matches returns true if the entry was created for the same NDF and updates
+// as the other entry. Round updates do not change once issued, so updates
+// with the same range of IDs are the same.
+func (e *pollCacheEntry) matches(other *pollCacheEntry) bool {
+	return e.numUpdates == other.numUpdates &&
+		e.firstUpdate == other.firstUpdate &&
+		e.lastUpdate == other.lastUpdate && bytes.Equal(e.ndf, other.ndf)
+}
@@ -0,0 +1,184 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"testing"
+)
+
+// newTestPollResponse returns a response to a poll with the updates and the
+// per client fields set from the filter value.
+func newTestPollResponse(ndf []byte, updateIDs []uint64,
+	filter byte) *pb.GatewayPollResponse {
+	updates := make([]*pb.RoundInfo, len(updateIDs))
+	for i, updateID := range updateIDs {
+		updates[i] = &pb.RoundInfo{ID: updateID + 100, UpdateID: updateID}
+	}
+	return &pb.GatewayPollResponse{
+		PartialNDF:    &pb.NDF{Ndf: ndf},
+		Updates:       updates,
+		KnownRounds:   []byte{filter},
+		Filters:       &pb.ClientBlooms{FirstTimestamp: int64(filter)},
+		EarliestRound: 5,
+		ReceivedTs:    int64(filter),
+	}
+}
+
+// Tests that pollCache.marshal produces the serialization of the full response
+// and reuses the serialized NDF and updates for clients with the same view.
+func TestPollCache_marshal(t *testing.T) {
+	cache := newPollCache(10)
+	msg := &pb.GatewayPoll{LastUpdate: 3, Partial: &pb.NDFHash{Hash: []byte("hash")}}
+
+	var shared []byte
+	for filter := byte(0); filter < 3; filter++ {
+		response := newTestPollResponse([]byte("ndf"), []uint64{4, 5}, filter)
+		data, err := cache.marshal(msg, response)
+		if err != nil {
+			t.Fatalf("Failed to marshal response: %+v", err)
+		}
+
+		received := &pb.GatewayPollResponse{}
+		if err = proto.Unmarshal(data, received); err != nil {
+			t.Fatalf("Failed to unmarshal response: %+v", err)
+		} else if !proto.Equal(response, received) {
+			t.Errorf("Unexpected response.\nexpected: %v\nreceived: %v",
+				response, received)
+		}
+
+		entry := cache.entries[pollCacheKey{3, "hash", false}]
+		if shared != nil && &entry.data[0] != &shared[0] {
+			t.Errorf("Shared part not reused for client %d.", filter)
+		}
+		shared = entry.data
+	}
+}
+
+// Tests that pollCache.marshal serializes every field of the response, so that
+// a field added to GatewayPollResponse without being assigned to the shared or
+// the per client part is detected.
+func TestPollCache_marshal_AllFields(t *testing.T) {
+	response := &pb.GatewayPollResponse{}
+	fields := response.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		setTestField(response.ProtoReflect(), fields.Get(i), t)
+	}
+
+	data, err := newPollCache(10).marshal(&pb.GatewayPoll{}, response)
+	if err != nil {
+		t.Fatalf("Failed to marshal response: %+v", err)
+	}
+	received := &pb.GatewayPollResponse{}
+	if err = proto.Unmarshal(data, received); err != nil {
+		t.Fatalf("Failed to unmarshal response: %+v", err)
+	}
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); !received.ProtoReflect().Has(fd) {
+			t.Errorf("Field %s not serialized by the poll cache.", fd.Name())
+		}
+	}
+}
+
+// setTestField sets the field of the message to a value that is serialized:
+// a non-zero scalar, an empty message or a list with one such value.
+func setTestField(m protoreflect.Message, fd protoreflect.FieldDescriptor,
+	t *testing.T) {
+	if fd.IsMap() {
+		t.Fatalf("Map field %s not supported.", fd.Name())
+	}
+
+	var value protoreflect.Value
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.IsList() {
+			value = protoreflect.ValueOfMessage(
+				m.NewField(fd).List().NewElement().Message())
+		} else {
+			value = protoreflect.ValueOfMessage(m.NewField(fd).Message())
+		}
+	case protoreflect.BoolKind:
+		value = protoreflect.ValueOfBool(true)
+	case protoreflect.EnumKind:
+		value = protoreflect.ValueOfEnum(1)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind:
+		value = protoreflect.ValueOfInt32(1)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind:
+		value = protoreflect.ValueOfInt64(1)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		value = protoreflect.ValueOfUint32(1)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		value = protoreflect.ValueOfUint64(1)
+	case protoreflect.FloatKind:
+		value = protoreflect.ValueOfFloat32(1)
+	case protoreflect.DoubleKind:
+		value = protoreflect.ValueOfFloat64(1)
+	case protoreflect.StringKind:
+		value = protoreflect.ValueOfString("value")
+	case protoreflect.BytesKind:
+		value = protoreflect.ValueOfBytes([]byte("value"))
+	default:
+		t.Fatalf("Field %s has unsupported kind %s.", fd.Name(), fd.Kind())
+	}
+
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		list.Append(value)
+		return
+	}
+	m.Set(fd, value)
+}
+
+// Tests that pollCache.marshal does not reuse a cached entry once the NDF or
+// the updates for the view change.
+func TestPollCache_marshal_Changed(t *testing.T) {
+	cache := newPollCache(10)
+	msg := &pb.GatewayPoll{LastUpdate: 3}
+
+	responses := []*pb.GatewayPollResponse{
+		newTestPollResponse([]byte("ndf"), []uint64{4, 5}, 1),
+		newTestPollResponse([]byte("ndf"), []uint64{4, 5, 6}, 1),
+		newTestPollResponse([]byte("new ndf"), []uint64{4, 5, 6}, 1),
+	}
+	for i, response := range responses {
+		data, err := cache.marshal(msg, response)
+		if err != nil {
+			t.Fatalf("Failed to marshal response %d: %+v", i, err)
+		}
+		received := &pb.GatewayPollResponse{}
+		if err = proto.Unmarshal(data, received); err != nil {
+			t.Fatalf("Failed to unmarshal response %d: %+v", i, err)
+		} else if !proto.Equal(response, received) {
+			t.Errorf("Unexpected response %d.\nexpected: %v\nreceived: %v",
+				i, response, received)
+		}
+	}
+}
+
+// Tests that the oldest entry is evicted once the cache is full.
+func TestPollCache_Eviction(t *testing.T) {
+	cache := newPollCache(2)
+	response := newTestPollResponse(nil, nil, 0)
+	for lastUpdate := uint64(0); lastUpdate < 3; lastUpdate++ {
+		_, err := cache.marshal(&pb.GatewayPoll{LastUpdate: lastUpdate}, response)
+		if err != nil {
+			t.Fatalf("Failed to marshal response: %+v", err)
+		}
+	}
+
+	if len(cache.entries) != 2 {
+		t.Errorf("Unexpected number of entries: %d", len(cache.entries))
+	}
+	if _, exists := cache.entries[pollCacheKey{lastUpdate: 0}]; exists {
+		t.Errorf("Oldest entry not evicted.")
+	}
+}
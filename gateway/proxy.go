@@ -92,7 +92,8 @@ func (g *Comms) SendPutMessageProxy(host *connect.Host, messages *pb.GatewaySlot
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// Gateway -> Gateway forward client PutManyMessages.
+// Gateway -> Gateway forward client PutManyMessages. The response contains the
+// result of each slot if the receiving gateway reports them.
 func (g *Comms) SendPutManyMessagesProxy(host *connect.Host, messages *pb.GatewaySlots, timeout time.Duration) (*pb.GatewaySlotResponse, error) {
 
 	// Create the Send Function
@@ -130,7 +131,12 @@ func (g *Comms) SendPutManyMessagesProxy(host *connect.Host, messages *pb.Gatewa
 
 	// Marshall the result
 	result := &pb.GatewaySlotResponse{}
-	return result, ptypes.UnmarshalAny(resultMsg, result)
+	if err = ptypes.UnmarshalAny(resultMsg, result); err != nil {
+		return nil, err
+	}
+
+	// Ensure each slot has a result, if any are reported
+	return result, result.CheckSlotResults(len(messages.GetMessages()))
 }
 
 // Gateway -> Gateway forward client RequestMessages.
@@ -0,0 +1,159 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains gateway -> gateway proxying with failover between multiple targets
+
+package gateway
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+// Error messages.
+const (
+	noProxyHostsErr        = "no hosts to send %s to"
+	proxyFailoverTimeout   = "timed out sending %s after trying %d of %d hosts"
+	proxyFailoverExhausted = "failed to send %s to any of %d hosts"
+)
+
+// proxyConnErrors are parts of the errors returned by connect.ProtoComms.Send
+// when the host could not be reached, as opposed to errors returned by the
+// host itself.
+var proxyConnErrors = []string{
+	"context deadline exceeded",
+	"connection refused",
+	"host disconnected",
+	"Cannot send to a disconnected",
+	"connection is nil",
+	"Host is in cool down",
+	"Last try to connect to",
+	"Host address is blank",
+	connect.ProxyError,
+	connect.TooManyProxyError,
+}
+
+// Gateway -> Gateway forward client RequestClientKey, trying each host in
+// order until one is reached. Returns the host that handled the request.
+func (g *Comms) SendRequestClientKeyFailover(hosts []*connect.Host,
+	messages *pb.SignedClientKeyRequest, timeout time.Duration) (
+	*pb.SignedKeyResponse, *connect.Host, error) {
+	var result *pb.SignedKeyResponse
+	host, err := sendWithFailover(hosts, timeout, "RequestClientKey",
+		func(host *connect.Host, timeout time.Duration) (err error) {
+			result, err = g.SendRequestClientKey(host, messages, timeout)
+			return err
+		})
+	return result, host, err
+}
+
+// Gateway -> Gateway forward client PutMessage, trying each host in order
+// until one is reached. Returns the host that handled the request.
+//
+// A host that timed out may have received the message before failing over,
+// so the message may be received by more than one host.
+func (g *Comms) SendPutMessageProxyFailover(hosts []*connect.Host,
+	messages *pb.GatewaySlot, timeout time.Duration) (
+	*pb.GatewaySlotResponse, *connect.Host, error) {
+	var result *pb.GatewaySlotResponse
+	host, err := sendWithFailover(hosts, timeout, "PutMessage",
+		func(host *connect.Host, timeout time.Duration) (err error) {
+			result, err = g.SendPutMessageProxy(host, messages, timeout)
+			return err
+		})
+	return result, host, err
+}
+
+// Gateway -> Gateway forward client PutManyMessages, trying each host in
+// order until one is reached. Returns the host that handled the request.
+//
+// A host that timed out may have received the messages before failing over,
+// so the messages may be received by more than one host.
+func (g *Comms) SendPutManyMessagesProxyFailover(hosts []*connect.Host,
+	messages *pb.GatewaySlots, timeout time.Duration) (
+	*pb.GatewaySlotResponse, *connect.Host, error) {
+	var result *pb.GatewaySlotResponse
+	host, err := sendWithFailover(hosts, timeout, "PutManyMessages",
+		func(host *connect.Host, timeout time.Duration) (err error) {
+			result, err = g.SendPutManyMessagesProxy(host, messages, timeout)
+			return err
+		})
+	return result, host, err
+}
+
+// Gateway -> Gateway forward client RequestMessages, trying each host in
+// order until one is reached. Returns the host that handled the request.
+func (g *Comms) SendRequestMessagesFailover(hosts []*connect.Host,
+	messages *pb.GetMessages, timeout time.Duration) (
+	*pb.GetMessagesResponse, *connect.Host, error) {
+	var result *pb.GetMessagesResponse
+	host, err := sendWithFailover(hosts, timeout, "RequestMessages",
+		func(host *connect.Host, timeout time.Duration) (err error) {
+			result, err = g.SendRequestMessages(host, messages, timeout)
+			return err
+		})
+	return result, host, err
+}
+
+// sendWithFailover calls send on each host in order until a host is reached.
+// Each attempt is given an equal share of the time remaining of the timeout
+// between the hosts not yet tried, so that a host that hangs does not use up
+// the time of the hosts after it. Returns the host that was reached
+// along with the error it returned, which is not retried on the next host.
+// Returns a nil host if no host could be reached before the timeout.
+func sendWithFailover(hosts []*connect.Host, timeout time.Duration,
+	description string,
+	send func(host *connect.Host, timeout time.Duration) error) (
+	*connect.Host, error) {
+	if len(hosts) == 0 {
+		return nil, errors.Errorf(noProxyHostsErr, description)
+	}
+
+	deadline := time.Now().Add(timeout)
+	var errs []string
+	for i, host := range hosts {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, errors.Errorf(proxyFailoverTimeout+": %s",
+				description, i, len(hosts), strings.Join(errs, "; "))
+		}
+
+		err := send(host, remaining/time.Duration(len(hosts)-i))
+		if err == nil || !isProxyConnError(err) {
+			return host, err
+		}
+
+		jww.WARN.Printf("Failed to send %s to %s (%d of %d), failing over: "+
+			"%+v", description, host.GetId(), i+1, len(hosts), err)
+		errs = append(errs, host.GetId().String()+": "+err.Error())
+	}
+
+	return nil, errors.Errorf(proxyFailoverExhausted+": %s",
+		description, len(hosts), strings.Join(errs, "; "))
+}
+
+// isProxyConnError returns true if the error indicates that the host could
+// not be reached, so the request should be sent to the next host.
+func isProxyConnError(err error) bool {
+	switch status.Code(errors.Cause(err)) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+
+	for _, connErr := range proxyConnErrors {
+		if strings.Contains(err.Error(), connErr) {
+			return true
+		}
+	}
+	return false
+}
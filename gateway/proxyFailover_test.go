@@ -0,0 +1,156 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Tests that SendPutMessageProxyFailover skips a host that cannot be reached
+// and reports the host that accepted the message.
+func TestComms_SendPutMessageProxyFailover(t *testing.T) {
+	gwAddress1 := getNextGatewayAddress()
+	gwAddress2 := getNextGatewayAddress()
+	testID1 := id.NewIdFromString("test1", id.Gateway, t)
+	testID2 := id.NewIdFromString("test2", id.Gateway, t)
+	deadID := id.NewIdFromString("dead", id.Gateway, t)
+	gw1 := StartGateway(testID1, gwAddress1, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	gw2 := StartGateway(testID2, gwAddress2, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw1.Shutdown()
+	defer gw2.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(testID2, gwAddress2, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+	params.DisableAutoConnect = true
+	deadHost, err := manager.AddHost(deadID, getNextGatewayAddress(), nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+
+	_, sentTo, err := gw1.SendPutMessageProxyFailover(
+		[]*connect.Host{deadHost, host}, &pb.GatewaySlot{}, 2*time.Minute)
+	if err != nil {
+		t.Fatalf("SendPutMessageProxyFailover error: %+v", err)
+	} else if sentTo != host {
+		t.Errorf("Unexpected host.\nexpected: %s\nreceived: %v",
+			host.GetId(), sentTo)
+	}
+
+	_, sentTo, err = gw1.SendPutMessageProxyFailover(
+		[]*connect.Host{deadHost}, &pb.GatewaySlot{}, 2*time.Minute)
+	if err == nil || sentTo != nil {
+		t.Errorf("Expected failure with no reachable hosts: %v, %+v", sentTo, err)
+	}
+}
+
+// Tests that an error returned by a reached host is returned without failing
+// over to the next host.
+func TestComms_SendRequestMessagesFailover_HostError(t *testing.T) {
+	gwAddress1 := getNextGatewayAddress()
+	gwAddress2 := getNextGatewayAddress()
+	gwAddress3 := getNextGatewayAddress()
+	testID1 := id.NewIdFromString("test1", id.Gateway, t)
+	testID2 := id.NewIdFromString("test2", id.Gateway, t)
+	testID3 := id.NewIdFromString("test3", id.Gateway, t)
+
+	failing := NewImplementation()
+	failing.Functions.RequestMessages = func(*pb.GetMessages) (*pb.GetMessagesResponse, error) {
+		return nil, errors.New("round not found")
+	}
+	called := false
+	working := NewImplementation()
+	working.Functions.RequestMessages = func(*pb.GetMessages) (*pb.GetMessagesResponse, error) {
+		called = true
+		return &pb.GetMessagesResponse{}, nil
+	}
+
+	gw1 := StartGateway(testID1, gwAddress1, NewImplementation(), nil, nil,
+		gossip.DefaultManagerFlags())
+	gw2 := StartGateway(testID2, gwAddress2, failing, nil, nil,
+		gossip.DefaultManagerFlags())
+	gw3 := StartGateway(testID3, gwAddress3, working, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gw1.Shutdown()
+	defer gw2.Shutdown()
+	defer gw3.Shutdown()
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host2, err := manager.AddHost(testID2, gwAddress2, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+	host3, err := manager.AddHost(testID3, gwAddress3, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host to manager: %+v", err)
+	}
+
+	_, sentTo, err := gw1.SendRequestMessagesFailover(
+		[]*connect.Host{host2, host3}, &pb.GetMessages{}, 2*time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "round not found") {
+		t.Errorf("Expected error from host: %+v", err)
+	}
+	if sentTo != host2 || called {
+		t.Errorf("Failed over after error returned by host.")
+	}
+}
+
+// Tests that sendWithFailover gives each attempt a share of the remaining
+// time, so that a host that hangs does not use up the timeout.
+func TestSendWithFailover_PerAttemptTimeout(t *testing.T) {
+	manager := connect.NewManagerTesting(t)
+	params := connect.GetDefaultHostParams()
+	params.DisableAutoConnect = true
+	var hosts []*connect.Host
+	for i := 0; i < 3; i++ {
+		host, err := manager.AddHost(
+			id.NewIdFromUInt(uint64(i), id.Gateway, t), "0.0.0.0:0", nil, params)
+		if err != nil {
+			t.Fatalf("Failed to add host to manager: %+v", err)
+		}
+		hosts = append(hosts, host)
+	}
+
+	timeout := 300 * time.Millisecond
+	var timeouts []time.Duration
+	sentTo, err := sendWithFailover(hosts, timeout, "test",
+		func(host *connect.Host, timeout time.Duration) error {
+			timeouts = append(timeouts, timeout)
+			if host == hosts[0] {
+				time.Sleep(timeout)
+				return errors.New("context deadline exceeded")
+			}
+			return nil
+		})
+	if err != nil || sentTo != hosts[1] {
+		t.Fatalf("Unexpected result: %v, %+v", sentTo, err)
+	}
+	if timeouts[0] > timeout/3 {
+		t.Errorf("First attempt given %s of %s for 3 hosts",
+			timeouts[0], timeout)
+	}
+	if timeouts[1] < timeout/4 || timeouts[1] > timeout/3+time.Millisecond {
+		t.Errorf("Second attempt given %s of the %s remaining for 2 hosts",
+			timeouts[1], timeout-timeouts[0])
+	}
+}
@@ -0,0 +1,263 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the per client rate limits applied to gateway ingress endpoints
+
+package gateway
+
+import (
+	"encoding/base64"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/rateLimiting"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Names of the rate limits, used in the errors returned to clients.
+const (
+	ipAddrLimit      = "IP address"
+	receptionIDLimit = "reception ID"
+	senderIDLimit    = "sender ID"
+)
+
+// Error messages.
+const (
+	exceedsCapacityErr = "request costing %d tokens exceeds the %s rate " +
+		"limit capacity of %d"
+)
+
+// RateLimitParams configures the per client rate limits on the PutMessage,
+// PutManyMessages, Poll and message request endpoints. Each limit keeps a
+// bucket per key that can hold Capacity tokens and leaks LeakedTokens every
+// LeakDuration. Requests that would overflow a bucket are rejected, without
+// adding tokens to any bucket, with a ResourceExhausted error carrying the
+// time until they would be accepted, which clients can read with
+// mixmessages.GetRetryAfter. Requests costing more tokens than a Capacity are
+// rejected with an InvalidArgument error. Buckets unused for
+// BucketMaxAge are discarded every PollDuration. A limit with a zero
+// Capacity or LeakDuration is disabled.
+type RateLimitParams struct {
+	// IpAddr limits all these endpoints by the client's IP address. Each
+	// slot sent with PutManyMessages and each request in a
+	// RequestBatchMessages or RequestBatchMessagesStream batch costs one
+	// token.
+	IpAddr rateLimiting.MapParams
+
+	// ReceptionID limits Poll by the reception ID being polled.
+	ReceptionID rateLimiting.MapParams
+
+	// SenderID limits PutMessage and PutManyMessages by the sender ID of each
+	// slot.
+	SenderID rateLimiting.MapParams
+}
+
+// SetRateLimits replaces the rate limits of the gateway with new limits
+// configured by params. The state of the previous limits is discarded. Call
+// with a zero RateLimitParams to disable rate limiting.
+func (g *Comms) SetRateLimits(params RateLimitParams) {
+	limiter := newRateLimiter(params)
+	if old, ok := g.rateLimits.Swap(limiter).(*rateLimiter); ok && old != nil {
+		old.stop()
+	}
+}
+
+// getRateLimiter returns the current rate limits, or nil if none are set.
+func (g *Comms) getRateLimiter() *rateLimiter {
+	limiter, _ := g.rateLimits.Load().(*rateLimiter)
+	return limiter
+}
+
+// rateLimiter holds the buckets of each rate limit. Disabled limits are nil.
+type rateLimiter struct {
+	ipAddr      *rateLimit
+	receptionID *rateLimit
+	senderID    *rateLimit
+	quit        chan struct{}
+}
+
+// newRateLimiter creates the buckets for each enabled limit in the params
+// and starts their stale bucket removal.
+func newRateLimiter(params RateLimitParams) *rateLimiter {
+	quit := make(chan struct{})
+	return &rateLimiter{
+		ipAddr:      newRateLimit(ipAddrLimit, params.IpAddr, quit),
+		receptionID: newRateLimit(receptionIDLimit, params.ReceptionID, quit),
+		senderID:    newRateLimit(senderIDLimit, params.SenderID, quit),
+		quit:        quit,
+	}
+}
+
+// stop stops the stale bucket removal of all limits.
+func (rl *rateLimiter) stop() {
+	close(rl.quit)
+}
+
+// admit admits a request from the IP address in the context costing ipTokens
+// and carrying the slots, which each cost a token of their sender ID. All
+// limits are checked before tokens are added to any bucket, so a request
+// rejected by one limit does not consume the tokens of another. Requests whose
+// address cannot be determined are not limited by IP address.
+func (rl *rateLimiter) admit(ctx context.Context, ipTokens uint32,
+	slots ...*pb.GatewaySlot) error {
+	if rl == nil {
+		return nil
+	}
+	return admitAll(append(rl.ipAddrTokens(ctx, ipTokens),
+		rl.senderIDTokens(slots)...))
+}
+
+// admitPoll admits a poll from the IP address in the context for the
+// reception ID, which costs a token of each limit. As with admit, both limits
+// are checked before tokens are added to either.
+func (rl *rateLimiter) admitPoll(ctx context.Context,
+	receptionID []byte) error {
+	if rl == nil {
+		return nil
+	}
+
+	pending := rl.ipAddrTokens(ctx, 1)
+	if rl.receptionID != nil {
+		pending = append(pending, bucketTokens{rl.receptionID,
+			base64.StdEncoding.EncodeToString(receptionID), 1})
+	}
+	return admitAll(pending)
+}
+
+// ipAddrTokens returns the tokens to add to the bucket of the IP address in
+// the context, or nil if it is not limited.
+func (rl *rateLimiter) ipAddrTokens(ctx context.Context,
+	tokens uint32) []bucketTokens {
+	if rl.ipAddr == nil {
+		return nil
+	}
+
+	ipAddr, _, err := connect.GetAddressFromContext(ctx)
+	if err != nil {
+		jww.WARN.Printf("Not rate limiting request without address: %+v", err)
+		return nil
+	}
+	return []bucketTokens{{rl.ipAddr, ipAddr, tokens}}
+}
+
+// senderIDTokens returns the tokens to add to the bucket of the sender ID of
+// each slot, or nil if they are not limited.
+func (rl *rateLimiter) senderIDTokens(slots []*pb.GatewaySlot) []bucketTokens {
+	if rl.senderID == nil {
+		return nil
+	}
+
+	// Count the slots of each sender so each bucket is checked once
+	senders := make(map[string]uint32, len(slots))
+	for _, slot := range slots {
+		senders[base64.StdEncoding.EncodeToString(
+			slot.GetMessage().GetSenderID())]++
+	}
+
+	pending := make([]bucketTokens, 0, len(senders))
+	for senderID, tokens := range senders {
+		pending = append(pending, bucketTokens{rl.senderID, senderID, tokens})
+	}
+	return pending
+}
+
+// bucketTokens is the number of tokens a request adds to the bucket of a key
+// in a limit.
+type bucketTokens struct {
+	limit  *rateLimit
+	key    string
+	tokens uint32
+}
+
+// admitAll checks that each bucket can hold its tokens and only then adds
+// them. Returns the error of the first limit that rejects the request, in
+// which case no tokens are added.
+func admitAll(pending []bucketTokens) error {
+	buckets := make([]*rateLimiting.Bucket, len(pending))
+	for i, bt := range pending {
+		bucket, err := bt.limit.check(bt.key, bt.tokens)
+		if err != nil {
+			return err
+		}
+		buckets[i] = bucket
+	}
+
+	// A concurrent request may have filled a bucket since it was checked.
+	// The tokens are still added, so the next request is rejected.
+	for i, bt := range pending {
+		buckets[i].Add(bt.tokens)
+	}
+	return nil
+}
+
+// rateLimit is a single rate limit with a bucket per key.
+type rateLimit struct {
+	name     string
+	buckets  *rateLimiting.BucketMap
+	capacity uint32
+	leakRate float64 // Tokens per nanosecond
+}
+
+// newRateLimit returns the rate limit configured by params, or nil if it is
+// disabled.
+func newRateLimit(name string, params rateLimiting.MapParams,
+	quit chan struct{}) *rateLimit {
+	if params.Capacity == 0 || params.LeakedTokens == 0 ||
+		params.LeakDuration <= 0 {
+		return nil
+	}
+
+	// Stale buckets are only removed if a poll duration is set
+	if params.PollDuration <= 0 {
+		quit = nil
+	}
+
+	return &rateLimit{
+		name:     name,
+		buckets:  rateLimiting.CreateBucketMapFromParams(&params, nil, quit),
+		capacity: params.Capacity,
+		leakRate: float64(params.LeakedTokens) /
+			float64(params.LeakDuration.Nanoseconds()),
+	}
+}
+
+// check returns the bucket of the key if it can hold the tokens without
+// overflowing. Returns an InvalidArgument error if the tokens exceed the
+// capacity of the limit, as the request can never be admitted, and a rate
+// limited error if the bucket is too full. No tokens are added.
+func (l *rateLimit) check(key string, tokens uint32) (
+	*rateLimiting.Bucket, error) {
+	if tokens > l.capacity {
+		return nil, status.Errorf(codes.InvalidArgument, exceedsCapacityErr,
+			tokens, l.name, l.capacity)
+	}
+
+	bucket := l.buckets.LookupBucket(key)
+	if bucket.IsWhitelisted() {
+		return bucket, nil
+	}
+
+	// IsFull leaks the tokens accumulated since the bucket was last used, so
+	// that the remaining tokens read below are current
+	bucket.IsFull()
+	bucket.Lock()
+	remaining := uint64(bucket.Remaining())
+	bucket.Unlock()
+
+	overflow := remaining + uint64(tokens)
+	if overflow <= uint64(l.capacity) {
+		return bucket, nil
+	}
+
+	// Time until enough tokens leak for the request to fit in the bucket
+	retryAfter := time.Duration(
+		float64(overflow-uint64(l.capacity)) / l.leakRate)
+	return nil, pb.NewRateLimitedError(l.name, retryAfter)
+}
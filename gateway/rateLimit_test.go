@@ -0,0 +1,137 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/primitives/rateLimiting"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+// newTestRateLimiter returns a rateLimiter with the IP address and sender ID
+// limited to the capacities. The buckets do not leak during the test.
+func newTestRateLimiter(ipAddr, senderID uint32, t *testing.T) *rateLimiter {
+	params := func(capacity uint32) rateLimiting.MapParams {
+		return rateLimiting.MapParams{
+			Capacity:     capacity,
+			LeakedTokens: 1,
+			LeakDuration: time.Hour,
+		}
+	}
+	rl := newRateLimiter(RateLimitParams{
+		IpAddr:      params(ipAddr),
+		ReceptionID: params(1),
+		SenderID:    params(senderID),
+	})
+	t.Cleanup(rl.stop)
+	return rl
+}
+
+// newTestAddrContext returns a context from a client at the IP address.
+func newTestAddrContext(ipAddr string) context.Context {
+	return peer.NewContext(context.Background(),
+		&peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ipAddr), Port: 1}})
+}
+
+// newTestSlot returns a slot from the sender.
+func newTestSlot(senderID byte) *pb.GatewaySlot {
+	return &pb.GatewaySlot{Message: &pb.Slot{SenderID: []byte{senderID}}}
+}
+
+// Tests that rateLimiter.admit does not add tokens to the IP address bucket
+// when a sender ID bucket rejects the request, and vice versa.
+func TestRateLimiter_admit_NoPartialConsumption(t *testing.T) {
+	rl := newTestRateLimiter(3, 2, t)
+	ctx := newTestAddrContext("10.0.0.1")
+
+	if err := rl.admit(ctx, 2, newTestSlot(1), newTestSlot(1)); err != nil {
+		t.Fatalf("Failed to admit request: %+v", err)
+	}
+
+	// Sender 1 is full, so the IP address must not be charged
+	err := rl.admit(ctx, 1, newTestSlot(1))
+	if _, ok := pb.GetRetryAfter(err); !ok {
+		t.Fatalf("Expected rate limited error, received: %+v", err)
+	}
+	if err = rl.admit(ctx, 1, newTestSlot(2)); err != nil {
+		t.Fatalf("IP address charged for rejected request: %+v", err)
+	}
+
+	// The IP address is full, so sender 3 must not be charged
+	err = rl.admit(ctx, 1, newTestSlot(3), newTestSlot(3))
+	if _, ok := pb.GetRetryAfter(err); !ok {
+		t.Fatalf("Expected rate limited error, received: %+v", err)
+	}
+	err = rl.admit(newTestAddrContext("10.0.0.2"), 2,
+		newTestSlot(3), newTestSlot(3))
+	if err != nil {
+		t.Errorf("Sender ID charged for rejected request: %+v", err)
+	}
+}
+
+// Error path: Tests that rateLimiter.admit rejects a request costing more
+// tokens than a limit's capacity with an InvalidArgument error, without
+// charging any bucket.
+func TestRateLimiter_admit_ExceedsCapacity(t *testing.T) {
+	rl := newTestRateLimiter(3, 2, t)
+	ctx := newTestAddrContext("10.0.0.1")
+
+	err := rl.admit(ctx, 4)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error, received: %+v", err)
+	}
+
+	slots := []*pb.GatewaySlot{newTestSlot(1), newTestSlot(1), newTestSlot(1)}
+	err = rl.admit(ctx, uint32(len(slots)), slots...)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error, received: %+v", err)
+	}
+
+	if err = rl.admit(ctx, 3, newTestSlot(1), newTestSlot(1)); err != nil {
+		t.Errorf("Bucket charged for rejected request: %+v", err)
+	}
+}
+
+// Tests that rateLimiter.admitPoll does not add tokens to the IP address
+// bucket when the reception ID bucket rejects the poll.
+func TestRateLimiter_admitPoll(t *testing.T) {
+	rl := newTestRateLimiter(2, 1, t)
+	ctx := newTestAddrContext("10.0.0.1")
+
+	if err := rl.admitPoll(ctx, []byte{1}); err != nil {
+		t.Fatalf("Failed to admit poll: %+v", err)
+	}
+	if _, ok := pb.GetRetryAfter(rl.admitPoll(ctx, []byte{1})); !ok {
+		t.Fatal("Poll over reception ID limit admitted")
+	}
+	if err := rl.admitPoll(ctx, []byte{2}); err != nil {
+		t.Errorf("IP address charged for rejected poll: %+v", err)
+	}
+}
+
+// Tests that the batch message request endpoints are limited by IP address,
+// with each request in the batch costing a token.
+func TestComms_RequestBatchMessages_RateLimit(t *testing.T) {
+	g := &Comms{}
+	g.SetRateLimits(RateLimitParams{IpAddr: rateLimiting.MapParams{
+		Capacity: 3, LeakedTokens: 1, LeakDuration: time.Hour}})
+	t.Cleanup(g.getRateLimiter().stop)
+
+	batch := &pb.GetMessagesBatch{
+		Requests: []*pb.GetMessages{{}, {}, {}, {}}}
+	_, err := g.RequestBatchMessages(newTestAddrContext("10.0.0.1"), batch)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error, received: %+v", err)
+	}
+}
@@ -0,0 +1,252 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the replication of round messages between gateways in a team
+
+package gateway
+
+import (
+	"encoding/base64"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Limits on the round messages received from a team gateway.
+const (
+	// maxRoundMessagesSlots is the largest number of slots accepted for a
+	// round. It is well above the batch size of any round.
+	maxRoundMessagesSlots = 1 << 14
+
+	// maxRoundMessagesBytes is the largest total size of the slots accepted
+	// for a round.
+	maxRoundMessagesBytes = pb.MaxChunkedDataSize
+)
+
+// Error messages.
+const (
+	roundMessagesSlotsErr = "round %d has more than the maximum of %d slots"
+	roundMessagesBytesErr = "round %d has more than the maximum of %d bytes " +
+		"of slots"
+)
+
+// ReplicateRoundMessages streams the messages of a completed round to every
+// other gateway in the round's topology, so that clients can retrieve them
+// from any gateway in the team. The messages are sent to all team gateways in
+// parallel; the returned error lists each gateway that could not be reached.
+func (g *Comms) ReplicateRoundMessages(round *pb.RoundInfo,
+	msgs *pb.RoundMessages) error {
+	var wg sync.WaitGroup
+	var errs []string
+	var errsMux sync.Mutex
+	addErr := func(err error) {
+		errsMux.Lock()
+		errs = append(errs, err.Error())
+		errsMux.Unlock()
+	}
+
+	for _, nodeIdBytes := range round.GetTopology() {
+		nodeId, err := id.Unmarshal(nodeIdBytes)
+		if err != nil {
+			addErr(errors.Errorf("Invalid node ID in topology of round %d: %v",
+				round.GetID(), err))
+			continue
+		}
+
+		gatewayId := nodeId.DeepCopy()
+		gatewayId.SetType(id.Gateway)
+		if gatewayId.Cmp(g.GetId()) {
+			continue
+		}
+
+		host, ok := g.GetHost(gatewayId)
+		if !ok {
+			addErr(errors.Errorf("No host for team gateway %s", gatewayId))
+			continue
+		}
+
+		wg.Add(1)
+		go func(host *connect.Host) {
+			defer wg.Done()
+			if err := g.SendShareRoundMessages(host, msgs); err != nil {
+				addErr(errors.Errorf("Failed to replicate round %d to %s: %v",
+					msgs.RoundId, host.GetId(), err))
+			}
+		}(host)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errors.Errorf("Failed to replicate round %d messages to %d "+
+			"team gateways: %s", msgs.RoundId, len(errs), strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// SendShareRoundMessages streams the round's messages to the team gateway.
+func (g *Comms) SendShareRoundMessages(host *connect.Host,
+	msgs *pb.RoundMessages) error {
+	// Create streaming context so you can close stream later
+	ctx, cancel := connect.StreamingContext()
+	defer cancel()
+
+	// Add the round information to the streaming context
+	header := &pb.RoundMessages{RoundId: msgs.RoundId}
+	encodedStr := base64.StdEncoding.EncodeToString([]byte(header.String()))
+	ctx = metadata.AppendToOutgoingContext(ctx, pb.RoundMessagesHeader, encodedStr)
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		// Add authentication information to streaming context
+		ctx = g.PackAuthenticatedContext(host, ctx)
+
+		// Get the stream client
+		streamClient, err := pb.NewGatewayClient(conn.GetGrpcConn()).
+			ShareRoundMessages(ctx)
+		if err != nil {
+			return nil, errors.New(err.Error())
+		}
+		return streamClient, nil
+	}
+
+	jww.TRACE.Printf("Streaming ShareRoundMessages for round %d", msgs.RoundId)
+
+	// Execute the Stream function
+	resultClient, err := g.ProtoComms.Stream(host, f)
+	if err != nil {
+		return err
+	}
+	streamClient := resultClient.(pb.Gateway_ShareRoundMessagesClient)
+
+	// Stream each slot
+	for i, slot := range msgs.Messages {
+		if err = streamClient.Send(slot); err != nil {
+			return errors.Errorf("Could not stream slot (%d/%d) for "+
+				"round %d: %v", i, len(msgs.Messages), msgs.RoundId, err)
+		}
+	}
+
+	// Receive ack and cancel client streaming context
+	ack, err := streamClient.CloseAndRecv()
+	if err != nil {
+		return errors.Errorf("Could not receive final acknowledgement on "+
+			"streaming round %d messages: %v", msgs.RoundId, err)
+	}
+
+	if ack != nil && ack.Error != "" {
+		return errors.Errorf("Remote Server Error: %v", ack.Error)
+	}
+
+	return nil
+}
+
+// ShareRoundMessages receives the messages of a round streamed from another
+// gateway in the team and passes them to the handler once fully received.
+// Streams from unauthenticated gateways are rejected before they are read.
+func (g *Comms) ShareRoundMessages(stream pb.Gateway_ShareRoundMessagesServer) error {
+	// Extract the authentication info
+	authMsg, err := connect.UnpackAuthenticatedContext(stream.Context())
+	if err != nil {
+		return errors.Errorf("Unable to extract authentication info: %+v", err)
+	}
+
+	authState, err := g.AuthenticatedReceiver(authMsg, stream.Context())
+	if err != nil {
+		return errors.Errorf("Unable handles reception of AuthenticatedMessage: %+v", err)
+	}
+
+	// Reject unauthenticated gateways before reading any of the stream
+	if !authState.IsAuthenticated {
+		return connect.AuthError(authState.Sender.GetId())
+	}
+
+	msgs, err := GetRoundMessagesStreamHeader(stream)
+	if err != nil {
+		return errors.Errorf("Unable to get round messages header: %+v", err)
+	}
+
+	err = receiveRoundMessages(stream, msgs,
+		maxRoundMessagesSlots, maxRoundMessagesBytes)
+	if err != nil {
+		return err
+	}
+
+	ack := &messages.Ack{}
+	if err = g.handler.ShareRoundMessages(msgs, authState); err != nil {
+		ack.Error = err.Error()
+	}
+
+	return stream.SendAndClose(ack)
+}
+
+// receiveRoundMessages appends the slots received on the stream to the
+// messages until the stream ends. Returns a ResourceExhausted error if more
+// than maxSlots slots or maxBytes bytes of slots are received.
+func receiveRoundMessages(stream pb.Gateway_ShareRoundMessagesServer,
+	msgs *pb.RoundMessages, maxSlots, maxBytes int) error {
+	var size int
+	slot, err := stream.Recv()
+	for ; err == nil; slot, err = stream.Recv() {
+		if len(msgs.Messages) >= maxSlots {
+			return status.Errorf(codes.ResourceExhausted,
+				roundMessagesSlotsErr, msgs.RoundId, maxSlots)
+		}
+		if size += proto.Size(slot); size > maxBytes {
+			return status.Errorf(codes.ResourceExhausted,
+				roundMessagesBytesErr, msgs.RoundId, maxBytes)
+		}
+		msgs.Messages = append(msgs.Messages, slot)
+	}
+	if err != io.EOF {
+		return errors.Errorf("Error receiving messages via stream for "+
+			"round %d: %v", msgs.RoundId, err)
+	}
+
+	return nil
+}
+
+// GetRoundMessagesStreamHeader gets the header in the metadata from the server
+// stream and returns it or an error if it fails.
+func GetRoundMessagesStreamHeader(
+	stream pb.Gateway_ShareRoundMessagesServer) (*pb.RoundMessages, error) {
+
+	// Obtain the headers from server metadata
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return nil, errors.New("unable to retrieve meta data / header")
+	}
+
+	values := md.Get(pb.RoundMessagesHeader)
+	if len(values) == 0 {
+		return nil, errors.Errorf(pb.NoStreamingHeaderErr, "ShareRoundMessages")
+	}
+
+	// Unmarshall the header into a message
+	marshalledHeader, err := base64.StdEncoding.DecodeString(values[0])
+	if err != nil {
+		return nil, err
+	}
+	msgs := &pb.RoundMessages{}
+	err = proto.UnmarshalText(string(marshalledHeader), msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,171 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package gateway
+
+import (
+	"github.com/golang/protobuf/proto"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/testkeys"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/gossip"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"testing"
+)
+
+// teamGatewayID returns the gateway ID for the node ID.
+func teamGatewayID(nodeID *id.ID) *id.ID {
+	gatewayID := nodeID.DeepCopy()
+	gatewayID.SetType(id.Gateway)
+	return gatewayID
+}
+
+// Tests that ReplicateRoundMessages streams the round's messages to the other
+// gateways in the topology and reports gateways that cannot be reached.
+func TestComms_ReplicateRoundMessages(t *testing.T) {
+	nodeA := id.NewIdFromString("nodeA", id.Node, t)
+	nodeB := id.NewIdFromString("nodeB", id.Node, t)
+	nodeC := id.NewIdFromString("nodeC", id.Node, t)
+
+	received := make(chan *pb.RoundMessages, 2)
+	impl := NewImplementation()
+	impl.Functions.ShareRoundMessages = func(msgs *pb.RoundMessages,
+		auth *connect.Auth) error {
+		received <- msgs
+		return nil
+	}
+
+	certData := testkeys.LoadFromPath(testkeys.GetNodeCertPath())
+	keyData := testkeys.LoadFromPath(testkeys.GetNodeKeyPath())
+	gwAddressA, gwAddressB := getNextGatewayAddress(), getNextGatewayAddress()
+	gwA := StartGateway(teamGatewayID(nodeA), gwAddressA, NewImplementation(),
+		certData, keyData, gossip.DefaultManagerFlags())
+	gwB := StartGateway(teamGatewayID(nodeB), gwAddressB, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gwA.Shutdown()
+	defer gwB.Shutdown()
+
+	// Gateway A authenticates itself to gateway B with its key
+	params := connect.GetDefaultHostParams()
+	_, err := gwA.AddHost(teamGatewayID(nodeB), gwAddressB, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+	params.AuthEnabled = false
+	_, err = gwB.AddHost(teamGatewayID(nodeA), gwAddressA, certData, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	msgs := &pb.RoundMessages{
+		RoundId: 42,
+		Messages: []*pb.Slot{
+			{Index: 0, PayloadA: []byte("payloadA"), SenderID: []byte("a")},
+			{Index: 1, PayloadB: []byte("payloadB"), SenderID: []byte("b")},
+		},
+	}
+	round := &pb.RoundInfo{ID: 42, Topology: [][]byte{nodeA.Bytes(), nodeB.Bytes()}}
+
+	if err := gwA.ReplicateRoundMessages(round, msgs); err != nil {
+		t.Fatalf("ReplicateRoundMessages error: %+v", err)
+	}
+	if r := <-received; !proto.Equal(msgs, r) {
+		t.Errorf("Unexpected messages.\nexpected: %v\nreceived: %v", msgs, r)
+	}
+
+	round.Topology = append(round.Topology, nodeC.Bytes())
+	if err := gwA.ReplicateRoundMessages(round, msgs); err == nil {
+		t.Errorf("No error for team gateway without a host.")
+	}
+	if r := <-received; !proto.Equal(msgs, r) {
+		t.Errorf("Unexpected messages.\nexpected: %v\nreceived: %v", msgs, r)
+	}
+}
+
+// Error path: Tests that ShareRoundMessages rejects a stream from a gateway
+// that has not authenticated itself without passing it to the handler.
+func TestComms_ShareRoundMessages_Unauthenticated(t *testing.T) {
+	nodeA := id.NewIdFromString("nodeA", id.Node, t)
+	nodeB := id.NewIdFromString("nodeB", id.Node, t)
+
+	impl := NewImplementation()
+	impl.Functions.ShareRoundMessages = func(*pb.RoundMessages,
+		*connect.Auth) error {
+		t.Error("Unauthenticated round messages passed to the handler.")
+		return nil
+	}
+
+	gwAddressA, gwAddressB := getNextGatewayAddress(), getNextGatewayAddress()
+	gwA := StartGateway(teamGatewayID(nodeA), gwAddressA, NewImplementation(),
+		nil, nil, gossip.DefaultManagerFlags())
+	gwB := StartGateway(teamGatewayID(nodeB), gwAddressB, impl, nil, nil,
+		gossip.DefaultManagerFlags())
+	defer gwA.Shutdown()
+	defer gwB.Shutdown()
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := gwA.AddHost(teamGatewayID(nodeB), gwAddressB, nil, params)
+	if err != nil {
+		t.Fatalf("Failed to add host: %+v", err)
+	}
+
+	err = gwA.SendShareRoundMessages(host, &pb.RoundMessages{RoundId: 42,
+		Messages: []*pb.Slot{{Index: 0, PayloadA: []byte("payloadA")}}})
+	if err == nil {
+		t.Errorf("Unauthenticated round messages accepted.")
+	}
+}
+
+// mockRoundMessagesStream is a pb.Gateway_ShareRoundMessagesServer that
+// receives the slots.
+type mockRoundMessagesStream struct {
+	pb.Gateway_ShareRoundMessagesServer
+	slots []*pb.Slot
+}
+
+func (m *mockRoundMessagesStream) Recv() (*pb.Slot, error) {
+	if len(m.slots) == 0 {
+		return nil, io.EOF
+	}
+	slot := m.slots[0]
+	m.slots = m.slots[1:]
+	return slot, nil
+}
+
+// Tests that receiveRoundMessages returns a ResourceExhausted error when more
+// than the maximum number of slots or bytes are received.
+func TestReceiveRoundMessages_Limits(t *testing.T) {
+	slots := []*pb.Slot{{PayloadA: make([]byte, 100)},
+		{PayloadA: make([]byte, 100)}, {PayloadA: make([]byte, 100)}}
+	size := proto.Size(slots[0])
+
+	tests := []struct {
+		maxSlots, maxBytes int
+		code               codes.Code
+	}{
+		{3, 3 * size, codes.OK},
+		{2, 3 * size, codes.ResourceExhausted},
+		{3, 3*size - 1, codes.ResourceExhausted},
+	}
+
+	for i, tt := range tests {
+		stream := &mockRoundMessagesStream{slots: slots}
+		msgs := &pb.RoundMessages{RoundId: 42}
+		err := receiveRoundMessages(stream, msgs, tt.maxSlots, tt.maxBytes)
+		if status.Code(err) != tt.code {
+			t.Errorf("Unexpected error (%d).\nexpected: %s\nreceived: %+v",
+				i, tt.code, err)
+		} else if err == nil && len(msgs.Messages) != len(slots) {
+			t.Errorf("Received %d slots, expected %d (%d).",
+				len(msgs.Messages), len(slots), i)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Package middleware contains the hooks used to add cross-cutting behaviour,
+// such as logging, metrics, rate limiting or additional auth checks, to the
+// Handler of a server. Each server package provides a WithMiddleware function
+// that wraps its Handler with a Chain.
+package middleware
+
+import (
+	"gitlab.com/xx_network/comms/connect"
+	"time"
+)
+
+// Call describes a single call to an endpoint of a Handler.
+type Call struct {
+	// Endpoint is the name of the Handler method being called.
+	Endpoint string
+
+	// Request is the request message received by the endpoint. It is nil for
+	// endpoints that do not receive a single message, such as streams.
+	Request interface{}
+
+	// IpAddr is the address of the sender for endpoints that receive it and is
+	// empty otherwise.
+	IpAddr string
+
+	// Auth is the authentication state of the sender for authenticated
+	// endpoints and is nil otherwise.
+	Auth *connect.Auth
+}
+
+// Middleware contains the hooks called around each call to a Handler. Either
+// hook may be nil.
+type Middleware struct {
+	// Before is called before the Handler. If it returns an error, the call is
+	// rejected with that error without calling the Handler or the Before hooks
+	// of later middleware.
+	Before func(call *Call) error
+
+	// After is called once the call completes, with the time since Before was
+	// called and the error returned to the sender. It is only called if
+	// Before was called and did not reject the call.
+	After func(call *Call, latency time.Duration, err error)
+}
+
+// Chain is an ordered list of Middleware. Before hooks are called in order
+// and After hooks in reverse order, so each Middleware wraps those after it.
+type Chain []Middleware
+
+// NewChain returns a Chain of the middleware in the order given.
+func NewChain(middleware ...Middleware) Chain {
+	return middleware
+}
+
+// Invoke calls handle for the call, surrounded by the hooks of each
+// Middleware in the chain, and returns the error of the call.
+func (c Chain) Invoke(call *Call, handle func() error) error {
+	starts := make([]time.Time, 0, len(c))
+
+	var err error
+	for _, m := range c {
+		starts = append(starts, time.Now())
+		if m.Before != nil {
+			if err = m.Before(call); err != nil {
+				// The rejecting middleware did not accept the call, so its
+				// After hook is not called
+				starts = starts[:len(starts)-1]
+				break
+			}
+		}
+	}
+	if err == nil {
+		err = handle()
+	}
+
+	for i := len(starts) - 1; i >= 0; i-- {
+		if c[i].After != nil {
+			c[i].After(call, time.Since(starts[i]), err)
+		}
+	}
+
+	return err
+}
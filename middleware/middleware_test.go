@@ -0,0 +1,78 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package middleware
+
+import (
+	"github.com/pkg/errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newRecorder returns Middleware that appends the name and hook to the log.
+func newRecorder(name string, log *[]string, reject error) Middleware {
+	return Middleware{
+		Before: func(call *Call) error {
+			*log = append(*log, name+".Before:"+call.Endpoint)
+			return reject
+		},
+		After: func(call *Call, latency time.Duration, err error) {
+			entry := name + ".After:" + call.Endpoint
+			if err != nil {
+				entry += ":" + err.Error()
+			}
+			*log = append(*log, entry)
+		},
+	}
+}
+
+// Tests that Chain.Invoke calls the Before hooks in order, then the handler,
+// then the After hooks in reverse order with the handler's error.
+func TestChain_Invoke(t *testing.T) {
+	var log []string
+	chain := NewChain(newRecorder("a", &log, nil), Middleware{},
+		newRecorder("b", &log, nil))
+
+	handlerErr := errors.New("failed")
+	err := chain.Invoke(&Call{Endpoint: "Poll"}, func() error {
+		log = append(log, "handler")
+		return handlerErr
+	})
+	if err != handlerErr {
+		t.Errorf("Unexpected error.\nexpected: %v\nreceived: %v", handlerErr, err)
+	}
+
+	expected := []string{"a.Before:Poll", "b.Before:Poll", "handler",
+		"b.After:Poll:failed", "a.After:Poll:failed"}
+	if !reflect.DeepEqual(expected, log) {
+		t.Errorf("Unexpected calls.\nexpected: %v\nreceived: %v", expected, log)
+	}
+}
+
+// Tests that a Before hook that returns an error rejects the call without
+// calling the handler or later middleware.
+func TestChain_Invoke_Rejected(t *testing.T) {
+	var log []string
+	rejectErr := errors.New("rate limited")
+	chain := NewChain(newRecorder("a", &log, nil),
+		newRecorder("b", &log, rejectErr), newRecorder("c", &log, nil))
+
+	err := chain.Invoke(&Call{Endpoint: "Poll"}, func() error {
+		t.Errorf("Handler called for rejected call.")
+		return nil
+	})
+	if err != rejectErr {
+		t.Errorf("Unexpected error.\nexpected: %v\nreceived: %v", rejectErr, err)
+	}
+
+	expected := []string{"a.Before:Poll", "b.Before:Poll",
+		"a.After:Poll:rate limited"}
+	if !reflect.DeepEqual(expected, log) {
+		t.Errorf("Unexpected calls.\nexpected: %v\nreceived: %v", expected, log)
+	}
+}
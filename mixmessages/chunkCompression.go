@@ -0,0 +1,158 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the negotiation and implementation of compression for responses
+// streamed in chunks
+
+package mixmessages
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"strings"
+)
+
+// ChunkCompressionHeader is the streaming header used by the receiver of a
+// response streamed in chunks to advertise the compression algorithms it
+// supports, as a comma separated list in order of preference, and by the
+// sender to announce the algorithm it used. Responses must not be compressed
+// for receivers that do not advertise support.
+const ChunkCompressionHeader = "chunkCompression"
+
+// GzipChunkCompression compresses the serialized response with gzip.
+const GzipChunkCompression = "gzip"
+
+// supportedChunkCompression lists the supported compression algorithms in
+// order of preference.
+var supportedChunkCompression = []string{GzipChunkCompression}
+
+// Error messages.
+const (
+	unknownChunkCompressionErr = "unknown chunk compression algorithm %q"
+	chunkDecompressionErr      = "failed to decompress streamed response"
+	decompressedTooLargeErr    = "decompressed response exceeds the maximum of %d bytes"
+)
+
+// AdvertiseChunkCompression returns a copy of the outgoing context that
+// advertises support for all compression algorithms known to this package.
+func AdvertiseChunkCompression(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, ChunkCompressionHeader,
+		strings.Join(supportedChunkCompression, ","))
+}
+
+// NegotiateChunkCompression returns the compression algorithm to use for a
+// response to the receiver that sent the metadata. Returns an empty string if
+// the receiver does not advertise support for any known algorithm, in which
+// case the response must not be compressed.
+func NegotiateChunkCompression(md metadata.MD) string {
+	for _, value := range md.Get(ChunkCompressionHeader) {
+		for _, algorithm := range strings.Split(value, ",") {
+			algorithm = strings.TrimSpace(algorithm)
+			for _, supported := range supportedChunkCompression {
+				if algorithm == supported {
+					return algorithm
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// GetChunkCompression returns the compression algorithm the sender of a
+// response streamed in chunks announced in its header, or an empty string if
+// the response is not compressed.
+func GetChunkCompression(md metadata.MD) string {
+	if values := md.Get(ChunkCompressionHeader); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// SplitCompressedResponseIntoChunks serializes the message, compresses it with
+// the algorithm and splits it into ChunkSize chunks. An empty algorithm
+// produces the same chunks as SplitResponseIntoChunks.
+func SplitCompressedResponseIntoChunks(
+	message proto.Message, compression string) ([]*StreamChunk, error) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return SplitCompressedDataIntoChunks(data, compression)
+}
+
+// SplitCompressedDataIntoChunks compresses the serialized message with the
+// algorithm and splits it into ChunkSize chunks. An empty algorithm only
+// splits the data.
+func SplitCompressedDataIntoChunks(
+	data []byte, compression string) ([]*StreamChunk, error) {
+	switch compression {
+	case "":
+	case GzipChunkCompression:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		data = buf.Bytes()
+	default:
+		return nil, errors.Errorf(unknownChunkCompressionErr, compression)
+	}
+
+	return splitIntoChunks(data), nil
+}
+
+// AssembleCompressedChunksIntoResponse assembles the chunks produced by
+// SplitCompressedResponseIntoChunks with the algorithm, decompresses them and
+// deserializes the result into the response. The algorithm is the one
+// announced by the sender, as returned by GetChunkCompression. An empty
+// algorithm is the same as AssembleChunksIntoResponse.
+func AssembleCompressedChunksIntoResponse(chunks []*StreamChunk,
+	response proto.Message, compression string) error {
+	data, err := decompressChunkData(assembleChunks(chunks), compression)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(data, response)
+}
+
+// decompressChunkData decompresses the data assembled from chunks with the
+// algorithm. Data that decompresses to more than MaxChunkedDataSize bytes is
+// rejected.
+func decompressChunkData(data []byte, compression string) ([]byte, error) {
+	switch compression {
+	case "":
+		return data, nil
+	case GzipChunkCompression:
+	default:
+		return nil, errors.Errorf(unknownChunkCompressionErr, compression)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, chunkDecompressionErr)
+	}
+	defer r.Close()
+
+	decompressed, err := io.ReadAll(io.LimitReader(r, MaxChunkedDataSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, chunkDecompressionErr)
+	} else if len(decompressed) > MaxChunkedDataSize {
+		return nil, errors.Errorf(decompressedTooLargeErr, MaxChunkedDataSize)
+	}
+	return decompressed, nil
+}
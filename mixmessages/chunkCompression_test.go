@@ -0,0 +1,137 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/metadata"
+	"strings"
+	"testing"
+)
+
+// Tests that a response split into compressed chunks is smaller than the
+// uncompressed response and is assembled back into the original by
+// AssembleCompressedChunksIntoResponse.
+func TestSplitCompressedResponseIntoChunks(t *testing.T) {
+	expected := &GatewayPollResponse{
+		KnownRounds: make([]byte, 10*ChunkSize),
+		Updates:     []*RoundInfo{{ID: 5, Topology: [][]byte{{1, 2, 3}}}},
+	}
+
+	uncompressed, err := SplitResponseIntoChunks(expected)
+	if err != nil {
+		t.Fatalf("Failed to split response: %+v", err)
+	}
+	compressed, err := SplitCompressedResponseIntoChunks(
+		expected, GzipChunkCompression)
+	if err != nil {
+		t.Fatalf("Failed to split compressed response: %+v", err)
+	}
+	if len(compressed) >= len(uncompressed) {
+		t.Errorf("Compressed response has %d chunks, uncompressed has %d.",
+			len(compressed), len(uncompressed))
+	}
+
+	for compression, chunks := range map[string][]*StreamChunk{
+		"": uncompressed, GzipChunkCompression: compressed} {
+		received := &GatewayPollResponse{}
+		err = AssembleCompressedChunksIntoResponse(chunks, received, compression)
+		if err != nil {
+			t.Errorf("Failed to assemble response: %+v", err)
+		} else if !proto.Equal(expected, received) {
+			t.Errorf("Unexpected response.\nexpected: %v\nreceived: %v",
+				expected, received)
+		}
+	}
+}
+
+// Error path: Tests that SplitCompressedResponseIntoChunks rejects unknown
+// algorithms.
+func TestSplitCompressedResponseIntoChunks_UnknownError(t *testing.T) {
+	_, err := SplitCompressedResponseIntoChunks(&GatewayPollResponse{}, "zip")
+	if err == nil {
+		t.Errorf("Failed to reject unknown compression algorithm.")
+	}
+}
+
+// Error path: Tests that compressed chunks are only decompressed with the
+// algorithm announced by the sender, which must be known.
+func TestAssembleCompressedChunksIntoResponse_Error(t *testing.T) {
+	chunks, err := SplitCompressedResponseIntoChunks(
+		&GatewayPollResponse{KnownRounds: []byte{1, 2, 3}}, GzipChunkCompression)
+	if err != nil {
+		t.Fatalf("Failed to split compressed response: %+v", err)
+	}
+
+	if err = AssembleChunksIntoResponse(chunks, &GatewayPollResponse{}); err == nil {
+		t.Error("Compressed chunks assembled without decompression")
+	}
+	err = AssembleCompressedChunksIntoResponse(
+		chunks, &GatewayPollResponse{}, "zip")
+	if err == nil {
+		t.Error("Failed to reject unknown compression algorithm.")
+	}
+}
+
+// Error path: Tests that AssembleCompressedChunksIntoResponse rejects data
+// that decompresses to more than MaxChunkedDataSize bytes.
+func TestAssembleCompressedChunksIntoResponse_TooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	zeros := make([]byte, 1<<20)
+	for written := 0; written <= MaxChunkedDataSize; written += len(zeros) {
+		if _, err := w.Write(zeros); err != nil {
+			t.Fatalf("Failed to compress: %+v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to compress: %+v", err)
+	}
+
+	err := AssembleCompressedChunksIntoResponse(splitIntoChunks(buf.Bytes()),
+		&GatewayPollResponse{}, GzipChunkCompression)
+	if err == nil || !strings.Contains(err.Error(), "exceeds the maximum") {
+		t.Errorf("Unexpected error for oversized data: %+v", err)
+	}
+}
+
+// Tests that GetChunkCompression returns the announced algorithm.
+func TestGetChunkCompression(t *testing.T) {
+	md := metadata.Pairs(ChunkCompressionHeader, GzipChunkCompression)
+	if c := GetChunkCompression(md); c != GzipChunkCompression {
+		t.Errorf("Unexpected compression.\nexpected: %q\nreceived: %q",
+			GzipChunkCompression, c)
+	}
+	if c := GetChunkCompression(metadata.MD{}); c != "" {
+		t.Errorf("Compression returned without announcement: %q", c)
+	}
+}
+
+// Tests that NegotiateChunkCompression selects gzip when it is advertised with
+// AdvertiseChunkCompression and no compression when nothing is advertised.
+func TestNegotiateChunkCompression(t *testing.T) {
+	ctx := AdvertiseChunkCompression(context.Background())
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if c := NegotiateChunkCompression(md); c != GzipChunkCompression {
+		t.Errorf("Unexpected compression.\nexpected: %q\nreceived: %q",
+			GzipChunkCompression, c)
+	}
+
+	md = metadata.Pairs(ChunkCompressionHeader, "zstd, gzip")
+	if c := NegotiateChunkCompression(md); c != GzipChunkCompression {
+		t.Errorf("Unexpected compression.\nexpected: %q\nreceived: %q",
+			GzipChunkCompression, c)
+	}
+
+	if c := NegotiateChunkCompression(metadata.MD{}); c != "" {
+		t.Errorf("Compression negotiated without advertisement: %q", c)
+	}
+}
@@ -0,0 +1,127 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the framing used to send and verify responses streamed in chunks.
+// It covers every RPC streaming StreamChunk messages. The batch streams to and
+// from the node send Slot messages instead, each carrying its index in the
+// batch, so they are not framed here.
+
+package mixmessages
+
+import (
+	"bytes"
+	"encoding/base64"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/crypto/hash"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"strconv"
+)
+
+// ChunkDigestHeader is the streaming header used to send the base 64 encoded
+// digest of the data of all chunks in a stream, in order. Its presence
+// indicates that the sender numbers its chunks, so receivers check the
+// sequence of each chunk and the digest of the whole stream. Streams from
+// older senders without the header are only checked against the chunk count.
+const ChunkDigestHeader = "chunkDigest"
+
+// Error messages.
+const (
+	missingChunkHeaderErr  = "missing %s header"
+	invalidChunkHeaderErr  = "invalid %s header: %v"
+	chunkOutOfSequenceErr  = "chunk %d of %d is missing or out of order: received chunk %d in its place"
+	tooManyChunksErr       = "received more than the %d chunks expected"
+	chunkLimitErr          = "%s header of %d chunks exceeds the maximum of %d"
+	chunkDataLimitErr      = "received more than the maximum of %d bytes of chunk data"
+	chunksMissingErr       = "stream ended after %d of %d chunks: chunk %d is missing"
+	chunkDigestMismatchErr = "digest of the %d received chunks does not match the digest sent; data is corrupted"
+	chunkStreamFailedErr   = "failed to complete streaming, received %d of %d chunks"
+)
+
+// NewChunkStreamHeader returns the metadata to send before streaming the
+// chunks. It contains the number of chunks and the digest of their data.
+func NewChunkStreamHeader(chunks []*StreamChunk) metadata.MD {
+	return metadata.Pairs(
+		ChunkHeader, strconv.Itoa(len(chunks)),
+		ChunkDigestHeader,
+		base64.StdEncoding.EncodeToString(digestChunks(chunks)))
+}
+
+// ReceiveChunks receives chunks using recv until it returns io.EOF and checks
+// them against the header sent with NewChunkStreamHeader. If the header
+// contains a digest, each chunk must arrive in sequence and the digest of all
+// chunks must match. The returned error identifies the chunk that was missing
+// or out of place. Streams of more than MaxChunkedDataSize bytes are rejected.
+func ReceiveChunks(md metadata.MD, recv func() (*StreamChunk, error)) (
+	[]*StreamChunk, error) {
+	chunkHeader := md.Get(ChunkHeader)
+	if len(chunkHeader) == 0 {
+		return nil, errors.Errorf(missingChunkHeaderErr, ChunkHeader)
+	}
+	totalChunks, err := strconv.Atoi(chunkHeader[0])
+	if err != nil || totalChunks < 0 {
+		return nil, errors.Errorf(invalidChunkHeaderErr, ChunkHeader, err)
+	} else if totalChunks > maxChunks {
+		return nil, errors.Errorf(
+			chunkLimitErr, ChunkHeader, totalChunks, maxChunks)
+	}
+
+	var expectedDigest []byte
+	sequenced := false
+	if digestHeader := md.Get(ChunkDigestHeader); len(digestHeader) > 0 {
+		expectedDigest, err = base64.StdEncoding.DecodeString(digestHeader[0])
+		if err != nil {
+			return nil, errors.Errorf(
+				invalidChunkHeaderErr, ChunkDigestHeader, err)
+		}
+		sequenced = true
+	}
+
+	// Receive the chunks
+	chunks := make([]*StreamChunk, 0, totalChunks)
+	dataSize := 0
+	chunk, err := recv()
+	for ; err == nil; chunk, err = recv() {
+		if len(chunks) == totalChunks {
+			return nil, errors.Errorf(tooManyChunksErr, totalChunks)
+		} else if sequenced && chunk.GetSequence() != uint64(len(chunks)) {
+			return nil, errors.Errorf(chunkOutOfSequenceErr,
+				len(chunks), totalChunks, chunk.GetSequence())
+		}
+		if dataSize += len(chunk.GetDatum()); dataSize > MaxChunkedDataSize {
+			return nil, errors.Errorf(chunkDataLimitErr, MaxChunkedDataSize)
+		}
+		chunks = append(chunks, chunk)
+	}
+	if err != io.EOF { // EOF is expected once the sender has completed streaming
+		return nil, errors.WithMessagef(
+			err, chunkStreamFailedErr, len(chunks), totalChunks)
+	} else if len(chunks) != totalChunks {
+		return nil, errors.Errorf(
+			chunksMissingErr, len(chunks), totalChunks, len(chunks))
+	}
+
+	if sequenced && !bytes.Equal(digestChunks(chunks), expectedDigest) {
+		return nil, errors.Errorf(chunkDigestMismatchErr, len(chunks))
+	}
+
+	return chunks, nil
+}
+
+// digestChunks returns the digest of the data of all chunks, in order.
+func digestChunks(chunks []*StreamChunk) []byte {
+	h, err := hash.NewCMixHash()
+	if err != nil {
+		jww.FATAL.Panicf("Could not get hash: %+v", err)
+	}
+
+	for _, chunk := range chunks {
+		h.Write(chunk.GetDatum())
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,121 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"google.golang.org/grpc/metadata"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newTestChunks returns the chunks of a response spanning several chunks and
+// the header to send with them.
+func newTestChunks(t *testing.T) ([]*StreamChunk, metadata.MD) {
+	chunks, err := SplitResponseIntoChunks(
+		&GatewayPollResponse{KnownRounds: make([]byte, 3*ChunkSize)})
+	if err != nil {
+		t.Fatalf("Failed to split response: %+v", err)
+	}
+	return chunks, NewChunkStreamHeader(chunks)
+}
+
+// newRecv returns a function that returns each chunk followed by io.EOF.
+func newRecv(chunks []*StreamChunk) func() (*StreamChunk, error) {
+	return func() (*StreamChunk, error) {
+		if len(chunks) == 0 {
+			return nil, io.EOF
+		}
+		chunk := chunks[0]
+		chunks = chunks[1:]
+		return chunk, nil
+	}
+}
+
+// Tests that ReceiveChunks returns all chunks of a valid stream, including one
+// from an older sender without sequence numbers or digest.
+func TestReceiveChunks(t *testing.T) {
+	chunks, md := newTestChunks(t)
+	received, err := ReceiveChunks(md, newRecv(chunks))
+	if err != nil || len(received) != len(chunks) {
+		t.Errorf("Failed to receive %d chunks: %+v", len(chunks), err)
+	}
+
+	legacy := make([]*StreamChunk, len(chunks))
+	for i, chunk := range chunks {
+		legacy[i] = &StreamChunk{Datum: chunk.Datum}
+	}
+	md = metadata.Pairs(ChunkHeader, strconv.Itoa(len(legacy)))
+	if _, err = ReceiveChunks(md, newRecv(legacy)); err != nil {
+		t.Errorf("Failed to receive unsequenced chunks: %+v", err)
+	}
+}
+
+// Error path: Tests that ReceiveChunks reports missing, reordered and
+// corrupted chunks.
+func TestReceiveChunks_Errors(t *testing.T) {
+	chunks, md := newTestChunks(t)
+	corrupted := &StreamChunk{
+		Datum:    append([]byte{1}, chunks[1].GetDatum()[1:]...),
+		Sequence: chunks[1].GetSequence(),
+	}
+
+	tests := []struct {
+		name     string
+		chunks   []*StreamChunk
+		expected string
+	}{
+		{"missing last", chunks[:len(chunks)-1],
+			"chunk " + strconv.Itoa(len(chunks)-1) + " is missing"},
+		{"missing middle", []*StreamChunk{chunks[0], chunks[2], chunks[3]},
+			"chunk 1 of"},
+		{"too many", append(chunks[:len(chunks):len(chunks)], chunks[0]),
+			"more than"},
+		{"corrupted", []*StreamChunk{chunks[0], corrupted, chunks[2], chunks[3]},
+			"does not match"},
+	}
+
+	for _, tt := range tests {
+		_, err := ReceiveChunks(md, newRecv(tt.chunks))
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%s: expected error containing %q, received: %+v",
+				tt.name, tt.expected, err)
+		}
+	}
+}
+
+// Error path: Tests that ReceiveChunks rejects a header announcing more chunks
+// than MaxChunkedDataSize allows without receiving any, and a stream whose
+// data exceeds it.
+func TestReceiveChunks_Limit(t *testing.T) {
+	md := metadata.Pairs(ChunkHeader, strconv.Itoa(maxChunks+1))
+	_, err := ReceiveChunks(md, func() (*StreamChunk, error) {
+		t.Error("Chunk received despite header exceeding the maximum")
+		return nil, io.EOF
+	})
+	if err == nil || !strings.Contains(err.Error(), "exceeds the maximum") {
+		t.Errorf("Unexpected error for too many chunks: %+v", err)
+	}
+
+	// Oversized chunks from a sender that does not number them
+	md = metadata.Pairs(ChunkHeader, strconv.Itoa(maxChunks))
+	chunk := &StreamChunk{Datum: make([]byte, MaxChunkedDataSize/8)}
+	received := 0
+	_, err = ReceiveChunks(md, func() (*StreamChunk, error) {
+		received++
+		return chunk, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "maximum of") {
+		t.Errorf("Unexpected error for too much data: %+v", err)
+	}
+	if received != 9 {
+		t.Errorf("Expected stream to stop after 9 chunks, received %d",
+			received)
+	}
+}
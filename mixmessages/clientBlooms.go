@@ -0,0 +1,140 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains functions to interpret the client bloom filters returned in a
+// GatewayPollResponse
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/id/ephemeral"
+	"math"
+	"time"
+)
+
+// Error messages.
+const (
+	noBloomDecoderErr    = "no bloom filter decoder"
+	decodeBloomFilterErr = "failed to decode bloom filter for rounds %d to %d"
+)
+
+// BloomFilter is a decoded client bloom filter.
+type BloomFilter interface {
+	// Test returns true if the data may have been added to the filter and
+	// false if it definitely was not.
+	Test(data []byte) bool
+}
+
+// BloomFilterDecoder decodes the Filter of a ClientBloom. The filter is
+// encoded by the gateway with the network's filter parameters, for example as
+// a marshalled gitlab.com/elixxir/bloomfilter.Bloom.
+type BloomFilterDecoder func(filter []byte) (BloomFilter, error)
+
+// GetRounds returns the first and last round covered by the filter. Both are
+// included. A range extending past the largest round ends at the largest
+// round.
+func (m *ClientBloom) GetRounds() (first, last id.Round) {
+	first = id.Round(m.GetFirstRound())
+	if uint64(m.GetRoundRange()) > math.MaxUint64-m.GetFirstRound() {
+		return first, math.MaxUint64
+	}
+	return first, first + id.Round(m.GetRoundRange())
+}
+
+// HasRound returns true if the round is covered by the filter.
+func (m *ClientBloom) HasRound(round id.Round) bool {
+	first, last := m.GetRounds()
+	return round >= first && round <= last
+}
+
+// Test decodes the filter and returns true if the ephemeral ID may have
+// received messages in the rounds covered by the filter. As with any bloom
+// filter, false positives are possible but false negatives are not.
+func (m *ClientBloom) Test(ephID ephemeral.Id, decode BloomFilterDecoder) (
+	bool, error) {
+	if decode == nil {
+		return false, errors.New(noBloomDecoderErr)
+	}
+
+	filter, err := decode(m.GetFilter())
+	if err != nil {
+		first, last := m.GetRounds()
+		return false, errors.WithMessagef(err, decodeBloomFilterErr, first, last)
+	}
+
+	return filter.Test(ephID[:]), nil
+}
+
+// GetWindow returns the period of time covered by the filter at the index.
+// The start is included and the end is not.
+func (m *ClientBlooms) GetWindow(index int) (start, end time.Time) {
+	start = time.Unix(0, m.GetFirstTimestamp()+int64(index)*m.GetPeriod())
+	return start, start.Add(time.Duration(m.GetPeriod()))
+}
+
+// GetFilterRange returns the indices of the first and last filters whose
+// window overlaps the time from start to end, such as the period in which an
+// ephemeral ID is valid. Both indices are included. Returns false if no filter
+// overlaps it.
+func (m *ClientBlooms) GetFilterRange(start, end time.Time) (
+	first, last int, ok bool) {
+	numFilters := len(m.GetFilters())
+	if numFilters == 0 || m.GetPeriod() <= 0 || !end.After(start) {
+		return 0, 0, false
+	}
+
+	// Index of the filter covering the time, before bounding it by the
+	// filters present
+	index := func(t time.Time) int64 {
+		offset := t.UnixNano() - m.GetFirstTimestamp()
+		if offset < 0 {
+			return -1
+		}
+		return offset / m.GetPeriod()
+	}
+
+	// The end is excluded, so the last filter is the one covering the
+	// nanosecond before it
+	firstIndex, lastIndex := index(start), index(end.Add(-1))
+	if lastIndex < 0 || firstIndex >= int64(numFilters) {
+		return 0, 0, false
+	}
+	if firstIndex < 0 {
+		firstIndex = 0
+	}
+	if lastIndex >= int64(numFilters) {
+		lastIndex = int64(numFilters) - 1
+	}
+
+	return int(firstIndex), int(lastIndex), true
+}
+
+// Match returns the filters whose window overlaps the time from start to end
+// and that may contain the ephemeral ID. The rounds covered by the returned
+// filters are the rounds in which the ephemeral ID may have received messages.
+func (m *ClientBlooms) Match(ephID ephemeral.Id, start, end time.Time,
+	decode BloomFilterDecoder) ([]*ClientBloom, error) {
+	first, last, ok := m.GetFilterRange(start, end)
+	if !ok {
+		return nil, nil
+	}
+
+	var matches []*ClientBloom
+	for _, filter := range m.GetFilters()[first : last+1] {
+		match, err := filter.Test(ephID, decode)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matches = append(matches, filter)
+		}
+	}
+
+	return matches, nil
+}
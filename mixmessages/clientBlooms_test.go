@@ -0,0 +1,158 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"bytes"
+	"github.com/pkg/errors"
+	"gitlab.com/xx_network/primitives/id"
+	"gitlab.com/xx_network/primitives/id/ephemeral"
+	"math"
+	"testing"
+	"time"
+)
+
+// testBloomFilter is an exact filter over the concatenated ephemeral IDs
+// making up its encoding.
+type testBloomFilter []byte
+
+func (f testBloomFilter) Test(data []byte) bool {
+	for i := 0; i+len(data) <= len(f); i += len(data) {
+		if bytes.Equal(f[i:i+len(data)], data) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeTestBloomFilter(filter []byte) (BloomFilter, error) {
+	return testBloomFilter(filter), nil
+}
+
+// Tests that ClientBloom.GetRounds includes both ends of the range.
+func TestClientBloom_GetRounds(t *testing.T) {
+	filter := &ClientBloom{FirstRound: 10, RoundRange: 5}
+	first, last := filter.GetRounds()
+	if first != 10 || last != 15 {
+		t.Errorf("Unexpected rounds %d to %d", first, last)
+	}
+
+	for round, expected := range map[id.Round]bool{
+		9: false, 10: true, 15: true, 16: false} {
+		if filter.HasRound(round) != expected {
+			t.Errorf("HasRound(%d) should be %t", round, expected)
+		}
+	}
+
+	// The range cannot extend past the largest round
+	filter = &ClientBloom{FirstRound: math.MaxUint64 - 1, RoundRange: 5}
+	if first, last = filter.GetRounds(); last != math.MaxUint64 {
+		t.Errorf("Unexpected rounds %d to %d", first, last)
+	}
+}
+
+// Tests that ClientBloom.Test tests the ephemeral ID against the decoded
+// filter and returns decoding errors.
+func TestClientBloom_Test(t *testing.T) {
+	ephID := ephemeral.Id{1, 2, 3, 4, 5, 6, 7, 8}
+	filter := &ClientBloom{Filter: ephID[:]}
+
+	if match, err := filter.Test(ephID, decodeTestBloomFilter); err != nil || !match {
+		t.Errorf("Ephemeral ID not matched: %t, %+v", match, err)
+	}
+	if match, _ := filter.Test(ephemeral.Id{}, decodeTestBloomFilter); match {
+		t.Error("Other ephemeral ID matched")
+	}
+
+	_, err := filter.Test(ephID, func([]byte) (BloomFilter, error) {
+		return nil, errors.New("bad filter")
+	})
+	if err == nil {
+		t.Error("No error for filter that could not be decoded")
+	}
+	if _, err = filter.Test(ephID, nil); err == nil {
+		t.Error("No error without decoder")
+	}
+}
+
+// Tests ClientBlooms.GetWindow.
+func TestClientBlooms_GetWindow(t *testing.T) {
+	blooms := &ClientBlooms{Period: int64(time.Minute), FirstTimestamp: 1000}
+	start, end := blooms.GetWindow(2)
+	if start.UnixNano() != 1000+int64(2*time.Minute) ||
+		end.Sub(start) != time.Minute {
+		t.Errorf("Unexpected window %s to %s", start, end)
+	}
+}
+
+// Tests that ClientBlooms.GetFilterRange returns the filters overlapping the
+// time range, bounded by the filters present.
+func TestClientBlooms_GetFilterRange(t *testing.T) {
+	first := time.Unix(1000, 0)
+	blooms := &ClientBlooms{
+		Period:         int64(time.Minute),
+		FirstTimestamp: first.UnixNano(),
+		Filters:        make([]*ClientBloom, 5),
+	}
+	at := func(minutes float64) time.Time {
+		return first.Add(time.Duration(minutes * float64(time.Minute)))
+	}
+
+	tests := []struct {
+		start, end  time.Time
+		first, last int
+		ok          bool
+	}{
+		{at(1.5), at(3.5), 1, 3, true},
+		{at(1), at(3), 1, 2, true},
+		{at(-10), at(2), 0, 1, true},
+		{at(4), at(10), 4, 4, true},
+		{at(-10), at(0), 0, 0, false},
+		{at(5), at(10), 0, 0, false},
+		{at(3), at(3), 0, 0, false},
+	}
+	for i, tt := range tests {
+		first, last, ok := blooms.GetFilterRange(tt.start, tt.end)
+		if ok != tt.ok || (ok && (first != tt.first || last != tt.last)) {
+			t.Errorf("Test %d: expected %d to %d (%t), received %d to %d (%t)",
+				i, tt.first, tt.last, tt.ok, first, last, ok)
+		}
+	}
+
+	if _, _, ok := (&ClientBlooms{}).GetFilterRange(at(0), at(1)); ok {
+		t.Error("Filter range found without filters")
+	}
+}
+
+// Tests that ClientBlooms.Match returns only the matching filters in the time
+// range.
+func TestClientBlooms_Match(t *testing.T) {
+	ephID := ephemeral.Id{1, 2, 3, 4, 5, 6, 7, 8}
+	other := ephemeral.Id{8, 7, 6, 5, 4, 3, 2, 1}
+	first := time.Unix(1000, 0)
+	blooms := &ClientBlooms{
+		Period:         int64(time.Minute),
+		FirstTimestamp: first.UnixNano(),
+		Filters: []*ClientBloom{
+			{Filter: ephID[:], FirstRound: 1, RoundRange: 1},
+			{Filter: other[:], FirstRound: 3, RoundRange: 1},
+			{Filter: append(other[:], ephID[:]...), FirstRound: 5, RoundRange: 1},
+			{Filter: ephID[:], FirstRound: 7, RoundRange: 1},
+		},
+	}
+
+	matches, err := blooms.Match(ephID, first.Add(time.Minute),
+		first.Add(10*time.Minute), decodeTestBloomFilter)
+	if err != nil {
+		t.Fatalf("Match error: %+v", err)
+	}
+	if len(matches) != 2 || matches[0] != blooms.Filters[2] ||
+		matches[1] != blooms.Filters[3] {
+		t.Errorf("Unexpected matches: %v", matches)
+	}
+}
@@ -0,0 +1,97 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains functions to build and interpret the per slot results of a
+// GatewaySlots batch
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Error messages.
+const (
+	slotResultCountErr = "received %d slot results for %d slots"
+)
+
+// AcceptedSlot returns the result of a slot accepted into the round.
+func AcceptedSlot(roundID uint64) *GatewaySlotResult {
+	return &GatewaySlotResult{Status: GatewaySlotStatus_SLOT_ACCEPTED,
+		RoundID: roundID}
+}
+
+// RejectedSlot returns the result of a slot rejected for the reason.
+func RejectedSlot(reason GatewaySlotRejection) *GatewaySlotResult {
+	return &GatewaySlotResult{Status: GatewaySlotStatus_SLOT_REJECTED,
+		Reason: reason}
+}
+
+// NewGatewaySlotResponse returns the response to a processed GatewaySlots
+// batch containing the result of each slot, in order. The response is marked
+// as accepted, which keeps its meaning of the batch having been processed for
+// receivers unaware of slot results; rejected slots are only reported in the
+// slot results.
+func NewGatewaySlotResponse(
+	roundID uint64, results []*GatewaySlotResult) *GatewaySlotResponse {
+	return &GatewaySlotResponse{
+		Accepted:    true,
+		RoundID:     roundID,
+		SlotResults: results,
+	}
+}
+
+// CheckSlotResults returns an error if the response contains slot results
+// that do not correspond to a batch of numSlots slots. A response without slot
+// results is valid.
+func (m *GatewaySlotResponse) CheckSlotResults(numSlots int) error {
+	if n := len(m.GetSlotResults()); n != 0 && n != numSlots {
+		return errors.Errorf(slotResultCountErr, n, numSlots)
+	}
+	return nil
+}
+
+// GetSlotResult returns the result of slot i of the batch. If the gateway did
+// not report slot results, the result is derived from the batch wide
+// acceptance.
+func (m *GatewaySlotResponse) GetSlotResult(i int) *GatewaySlotResult {
+	if results := m.GetSlotResults(); len(results) > 0 {
+		if i < 0 || i >= len(results) {
+			return &GatewaySlotResult{}
+		}
+		return results[i]
+	}
+
+	if m.GetAccepted() {
+		return AcceptedSlot(m.GetRoundID())
+	}
+	return RejectedSlot(GatewaySlotRejection_SLOT_REJECTION_NONE)
+}
+
+// Rejected returns a batch containing only the slots that the response does
+// not report as accepted, to be resent. Returns nil if every slot was
+// accepted.
+func (m *GatewaySlots) Rejected(response *GatewaySlotResponse) *GatewaySlots {
+	var rejected []*GatewaySlot
+	for i, slot := range m.GetMessages() {
+		if response.GetSlotResult(i).GetStatus() !=
+			GatewaySlotStatus_SLOT_ACCEPTED {
+			rejected = append(rejected, slot)
+		}
+	}
+
+	if len(rejected) == 0 {
+		return nil
+	}
+
+	return &GatewaySlots{
+		Messages: rejected,
+		RoundID:  m.GetRoundID(),
+		Target:   m.GetTarget(),
+		IpAddr:   m.GetIpAddr(),
+	}
+}
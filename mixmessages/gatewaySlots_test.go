@@ -0,0 +1,62 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+)
+
+// Tests that GatewaySlots.Rejected returns the slots not reported as accepted,
+// both with and without per slot results.
+func TestGatewaySlots_Rejected(t *testing.T) {
+	slots := &GatewaySlots{RoundID: 5, Target: []byte("target"),
+		Messages: []*GatewaySlot{{MAC: []byte{0}}, {MAC: []byte{1}}}}
+
+	resp := NewGatewaySlotResponse(5, []*GatewaySlotResult{
+		RejectedSlot(GatewaySlotRejection_SLOT_REJECTION_ROUND_FULL),
+		AcceptedSlot(5)})
+	rejected := slots.Rejected(resp)
+	if len(rejected.GetMessages()) != 1 ||
+		rejected.GetMessages()[0] != slots.Messages[0] ||
+		string(rejected.GetTarget()) != "target" {
+		t.Errorf("Unexpected rejected slots: %v", rejected)
+	}
+
+	// Responses without slot results apply to the whole batch
+	if rejected = slots.Rejected(&GatewaySlotResponse{}); len(rejected.GetMessages()) != 2 {
+		t.Errorf("Expected all slots rejected, received: %v", rejected)
+	}
+	if rejected = slots.Rejected(&GatewaySlotResponse{Accepted: true}); rejected != nil {
+		t.Errorf("Expected no slots rejected, received: %v", rejected)
+	}
+}
+
+// Tests that NewGatewaySlotResponse marks the batch accepted even when slots
+// were rejected and that CheckSlotResults detects a mismatched count.
+func TestNewGatewaySlotResponse(t *testing.T) {
+	resp := NewGatewaySlotResponse(3, []*GatewaySlotResult{
+		AcceptedSlot(3), AcceptedSlot(3)})
+	if !resp.GetAccepted() {
+		t.Errorf("Batch with all slots accepted not marked accepted.")
+	}
+	if err := resp.CheckSlotResults(2); err != nil {
+		t.Errorf("CheckSlotResults error: %+v", err)
+	}
+	if err := resp.CheckSlotResults(3); err == nil {
+		t.Errorf("CheckSlotResults failed to detect a mismatched count.")
+	}
+
+	resp = NewGatewaySlotResponse(3, []*GatewaySlotResult{AcceptedSlot(3),
+		RejectedSlot(GatewaySlotRejection_SLOT_REJECTION_INVALID)})
+	if !resp.GetAccepted() {
+		t.Errorf("Processed batch with a rejected slot not marked accepted.")
+	}
+	if resp.GetSlotResult(1).GetStatus() != GatewaySlotStatus_SLOT_REJECTED {
+		t.Errorf("Rejected slot not reported: %v", resp.GetSlotResult(1))
+	}
+}
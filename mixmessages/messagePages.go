@@ -0,0 +1,98 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains functions to build paginated responses to GetMessages requests
+
+package mixmessages
+
+import (
+	"encoding/binary"
+	"github.com/pkg/errors"
+)
+
+// Error messages.
+const (
+	continuationTokenLenErr   = "continuation token has length %d, expected %d"
+	continuationTokenRoundErr = "continuation token is for round %d, not %d"
+	continuationTokenIndexErr = "continuation token offset %d exceeds the %d messages in round %d"
+)
+
+// MaxMessagesPageSize is the largest number of messages NewMessagesPage
+// returns in a page. Requests without a page size, or with a larger one, are
+// served pages of this size.
+const MaxMessagesPageSize = 1000
+
+// continuationTokenLen is the length of a continuation token: the round ID
+// followed by the offset of the next message.
+const continuationTokenLen = 8 + 4
+
+// NewMessagesPage returns the page of messages requested by the GetMessages,
+// given all messages for the client in the round. If more messages remain
+// after the page, the response contains a continuation token for the next
+// request. Pages hold at most MaxMessagesPageSize messages, even if the
+// request has no page size. An error is returned if the request's continuation
+// token is invalid for the round.
+func NewMessagesPage(request *GetMessages, messages []*Slot,
+	hasRound bool) (*GetMessagesResponse, error) {
+	start := 0
+	if token := request.GetContinuationToken(); len(token) != 0 {
+		offset, err := parseContinuationToken(token, request.GetRoundID())
+		if err != nil {
+			return nil, err
+		}
+		if offset > len(messages) {
+			return nil, errors.Errorf(continuationTokenIndexErr,
+				offset, len(messages), request.GetRoundID())
+		}
+		start = offset
+	}
+
+	pageSize := int(request.GetPageSize())
+	if pageSize == 0 || pageSize > MaxMessagesPageSize {
+		pageSize = MaxMessagesPageSize
+	}
+	end := len(messages)
+	if start+pageSize < end {
+		end = start + pageSize
+	}
+
+	response := &GetMessagesResponse{
+		Messages: messages[start:end],
+		HasRound: hasRound,
+	}
+	if end < len(messages) {
+		response.ContinuationToken =
+			makeContinuationToken(request.GetRoundID(), end)
+	}
+
+	return response, nil
+}
+
+// makeContinuationToken encodes the round ID and the offset of the next
+// message into a continuation token.
+func makeContinuationToken(roundID uint64, offset int) []byte {
+	token := make([]byte, continuationTokenLen)
+	binary.BigEndian.PutUint64(token[:8], roundID)
+	binary.BigEndian.PutUint32(token[8:], uint32(offset))
+	return token
+}
+
+// parseContinuationToken returns the offset of the next message encoded in the
+// continuation token. An error is returned if the token is malformed or is for
+// a different round.
+func parseContinuationToken(token []byte, roundID uint64) (int, error) {
+	if len(token) != continuationTokenLen {
+		return 0, errors.Errorf(
+			continuationTokenLenErr, len(token), continuationTokenLen)
+	}
+
+	if tokenRound := binary.BigEndian.Uint64(token[:8]); tokenRound != roundID {
+		return 0, errors.Errorf(continuationTokenRoundErr, tokenRound, roundID)
+	}
+
+	return int(binary.BigEndian.Uint32(token[8:])), nil
+}
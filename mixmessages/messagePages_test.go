@@ -0,0 +1,102 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"testing"
+)
+
+// Tests that NewMessagesPage splits the messages into pages of the requested
+// size, linked by continuation tokens.
+func TestNewMessagesPage(t *testing.T) {
+	messages := make([]*Slot, 7)
+	for i := range messages {
+		messages[i] = &Slot{Index: uint32(i)}
+	}
+
+	request := &GetMessages{RoundID: 5, PageSize: 3}
+	var received []*Slot
+	for numPages := 1; ; numPages++ {
+		response, err := NewMessagesPage(request, messages, true)
+		if err != nil {
+			t.Fatalf("Failed to get page %d: %+v", numPages, err)
+		} else if !response.HasRound {
+			t.Errorf("HasRound not set on page %d.", numPages)
+		} else if len(response.Messages) > 3 {
+			t.Errorf("Page %d has %d messages.", numPages, len(response.Messages))
+		}
+		received = append(received, response.Messages...)
+
+		if response.ContinuationToken == nil {
+			if numPages != 3 {
+				t.Errorf("Unexpected number of pages: %d", numPages)
+			}
+			break
+		}
+		request.ContinuationToken = response.ContinuationToken
+	}
+
+	if len(received) != len(messages) {
+		t.Fatalf("Received %d of %d messages.", len(received), len(messages))
+	}
+	for i, slot := range received {
+		if slot.Index != uint32(i) {
+			t.Errorf("Message %d out of order: %d", i, slot.Index)
+		}
+	}
+}
+
+// Tests that NewMessagesPage returns all messages when no page size is set
+// and they fit in a page of the maximum size.
+func TestNewMessagesPage_NoPageSize(t *testing.T) {
+	messages := make([]*Slot, 7)
+	response, err := NewMessagesPage(&GetMessages{}, messages, false)
+	if err != nil {
+		t.Fatalf("Failed to get page: %+v", err)
+	}
+	if len(response.Messages) != len(messages) ||
+		response.ContinuationToken != nil {
+		t.Errorf("Unexpected response: %v", response)
+	}
+}
+
+// Tests that NewMessagesPage limits pages to MaxMessagesPageSize when the
+// request has no page size or a larger one.
+func TestNewMessagesPage_MaxPageSize(t *testing.T) {
+	messages := make([]*Slot, MaxMessagesPageSize+1)
+	for _, pageSize := range []uint32{0, MaxMessagesPageSize + 1} {
+		request := &GetMessages{RoundID: 5, PageSize: pageSize}
+		response, err := NewMessagesPage(request, messages, true)
+		if err != nil {
+			t.Fatalf("Failed to get page of size %d: %+v", pageSize, err)
+		}
+		if len(response.Messages) != MaxMessagesPageSize ||
+			response.ContinuationToken == nil {
+			t.Errorf("Page of size %d has %d messages and token %v",
+				pageSize, len(response.Messages), response.ContinuationToken)
+		}
+	}
+}
+
+// Tests that NewMessagesPage rejects malformed tokens and tokens for another
+// round or past the end of the messages.
+func TestNewMessagesPage_InvalidToken(t *testing.T) {
+	messages := make([]*Slot, 3)
+	tokens := [][]byte{
+		[]byte("bad token"),
+		makeContinuationToken(6, 1),
+		makeContinuationToken(5, 4),
+	}
+
+	for i, token := range tokens {
+		request := &GetMessages{RoundID: 5, PageSize: 1, ContinuationToken: token}
+		if _, err := NewMessagesPage(request, messages, true); err == nil {
+			t.Errorf("No error for invalid token %d.", i)
+		}
+	}
+}
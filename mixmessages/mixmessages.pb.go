@@ -29,6 +29,168 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// GatewaySlotStatus describes whether a slot was accepted into a round.
+type GatewaySlotStatus int32
+
+const (
+	GatewaySlotStatus_SLOT_STATUS_UNKNOWN GatewaySlotStatus = 0
+	GatewaySlotStatus_SLOT_ACCEPTED       GatewaySlotStatus = 1
+	GatewaySlotStatus_SLOT_REJECTED       GatewaySlotStatus = 2
+)
+
+// Enum value maps for GatewaySlotStatus.
+var (
+	GatewaySlotStatus_name = map[int32]string{
+		0: "SLOT_STATUS_UNKNOWN",
+		1: "SLOT_ACCEPTED",
+		2: "SLOT_REJECTED",
+	}
+	GatewaySlotStatus_value = map[string]int32{
+		"SLOT_STATUS_UNKNOWN": 0,
+		"SLOT_ACCEPTED":       1,
+		"SLOT_REJECTED":       2,
+	}
+)
+
+func (x GatewaySlotStatus) Enum() *GatewaySlotStatus {
+	p := new(GatewaySlotStatus)
+	*p = x
+	return p
+}
+
+func (x GatewaySlotStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewaySlotStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mixmessages_proto_enumTypes[0].Descriptor()
+}
+
+func (GatewaySlotStatus) Type() protoreflect.EnumType {
+	return &file_mixmessages_proto_enumTypes[0]
+}
+
+func (x GatewaySlotStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewaySlotStatus.Descriptor instead.
+func (GatewaySlotStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{0}
+}
+
+// GatewaySlotRejection is the reason a slot was rejected.
+type GatewaySlotRejection int32
+
+const (
+	GatewaySlotRejection_SLOT_REJECTION_NONE                GatewaySlotRejection = 0
+	GatewaySlotRejection_SLOT_REJECTION_INVALID             GatewaySlotRejection = 1
+	GatewaySlotRejection_SLOT_REJECTION_BAD_MAC             GatewaySlotRejection = 2
+	GatewaySlotRejection_SLOT_REJECTION_ROUND_FULL          GatewaySlotRejection = 3
+	GatewaySlotRejection_SLOT_REJECTION_ROUND_NOT_ACCEPTING GatewaySlotRejection = 4
+	GatewaySlotRejection_SLOT_REJECTION_INTERNAL            GatewaySlotRejection = 5
+)
+
+// Enum value maps for GatewaySlotRejection.
+var (
+	GatewaySlotRejection_name = map[int32]string{
+		0: "SLOT_REJECTION_NONE",
+		1: "SLOT_REJECTION_INVALID",
+		2: "SLOT_REJECTION_BAD_MAC",
+		3: "SLOT_REJECTION_ROUND_FULL",
+		4: "SLOT_REJECTION_ROUND_NOT_ACCEPTING",
+		5: "SLOT_REJECTION_INTERNAL",
+	}
+	GatewaySlotRejection_value = map[string]int32{
+		"SLOT_REJECTION_NONE":                0,
+		"SLOT_REJECTION_INVALID":             1,
+		"SLOT_REJECTION_BAD_MAC":             2,
+		"SLOT_REJECTION_ROUND_FULL":          3,
+		"SLOT_REJECTION_ROUND_NOT_ACCEPTING": 4,
+		"SLOT_REJECTION_INTERNAL":            5,
+	}
+)
+
+func (x GatewaySlotRejection) Enum() *GatewaySlotRejection {
+	p := new(GatewaySlotRejection)
+	*p = x
+	return p
+}
+
+func (x GatewaySlotRejection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GatewaySlotRejection) Descriptor() protoreflect.EnumDescriptor {
+	return file_mixmessages_proto_enumTypes[1].Descriptor()
+}
+
+func (GatewaySlotRejection) Type() protoreflect.EnumType {
+	return &file_mixmessages_proto_enumTypes[1]
+}
+
+func (x GatewaySlotRejection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GatewaySlotRejection.Descriptor instead.
+func (GatewaySlotRejection) EnumDescriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{1}
+}
+
+// RsChangeKind describes how a path was changed.
+type RsChangeKind int32
+
+const (
+	RsChangeKind_RS_CHANGE_UNKNOWN RsChangeKind = 0
+	RsChangeKind_RS_CHANGE_WRITE   RsChangeKind = 1
+	RsChangeKind_RS_CHANGE_DELETE  RsChangeKind = 2
+	RsChangeKind_RS_CHANGE_RENAME  RsChangeKind = 3
+)
+
+// Enum value maps for RsChangeKind.
+var (
+	RsChangeKind_name = map[int32]string{
+		0: "RS_CHANGE_UNKNOWN",
+		1: "RS_CHANGE_WRITE",
+		2: "RS_CHANGE_DELETE",
+		3: "RS_CHANGE_RENAME",
+	}
+	RsChangeKind_value = map[string]int32{
+		"RS_CHANGE_UNKNOWN": 0,
+		"RS_CHANGE_WRITE":   1,
+		"RS_CHANGE_DELETE":  2,
+		"RS_CHANGE_RENAME":  3,
+	}
+)
+
+func (x RsChangeKind) Enum() *RsChangeKind {
+	p := new(RsChangeKind)
+	*p = x
+	return p
+}
+
+func (x RsChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RsChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mixmessages_proto_enumTypes[2].Descriptor()
+}
+
+func (RsChangeKind) Type() protoreflect.EnumType {
+	return &file_mixmessages_proto_enumTypes[2]
+}
+
+func (x RsChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RsChangeKind.Descriptor instead.
+func (RsChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{2}
+}
+
 type ClientKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1347,6 +1509,9 @@ type StreamChunk struct {
 	unknownFields protoimpl.UnknownFields
 
 	Datum []byte `protobuf:"bytes,1,opt,name=Datum,proto3" json:"Datum,omitempty"`
+	// Position of the chunk in the stream, starting at 0. Only checked when
+	// the stream header contains a chunk digest.
+	Sequence uint64 `protobuf:"varint,2,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
 }
 
 func (x *StreamChunk) Reset() {
@@ -1388,6 +1553,13 @@ func (x *StreamChunk) GetDatum() []byte {
 	return nil
 }
 
+func (x *StreamChunk) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 // Client -> Gateway request for information about historical rounds
 type HistoricalRounds struct {
 	state         protoimpl.MessageState
@@ -1605,6 +1777,11 @@ type GetMessages struct {
 	ClientID []byte `protobuf:"bytes,1,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
 	RoundID  uint64 `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
 	Target   []byte `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+	// Maximum number of messages to return. If 0, or above the gateway's
+	// maximum, the gateway's maximum is used.
+	PageSize uint32 `protobuf:"varint,4,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// Opaque token from the previous GetMessagesResponse to continue from
+	ContinuationToken []byte `protobuf:"bytes,5,opt,name=ContinuationToken,proto3" json:"ContinuationToken,omitempty"`
 }
 
 func (x *GetMessages) Reset() {
@@ -1660,6 +1837,20 @@ func (x *GetMessages) GetTarget() []byte {
 	return nil
 }
 
+func (x *GetMessages) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMessages) GetContinuationToken() []byte {
+	if x != nil {
+		return x.ContinuationToken
+	}
+	return nil
+}
+
 // Gateway response to a GetMessages request
 type GetMessagesResponse struct {
 	state         protoimpl.MessageState
@@ -1668,6 +1859,8 @@ type GetMessagesResponse struct {
 
 	Messages []*Slot `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
 	HasRound bool    `protobuf:"varint,2,opt,name=HasRound,proto3" json:"HasRound,omitempty"`
+	// Set when more messages remain; pass it in the next GetMessages request
+	ContinuationToken []byte `protobuf:"bytes,3,opt,name=ContinuationToken,proto3" json:"ContinuationToken,omitempty"`
 }
 
 func (x *GetMessagesResponse) Reset() {
@@ -1716,6 +1909,13 @@ func (x *GetMessagesResponse) GetHasRound() bool {
 	return false
 }
 
+func (x *GetMessagesResponse) GetContinuationToken() []byte {
+	if x != nil {
+		return x.ContinuationToken
+	}
+	return nil
+}
+
 // Gateway -> Gateway message sharing within a team
 type RoundMessages struct {
 	state         protoimpl.MessageState
@@ -2193,6 +2393,137 @@ func (x *GatewayPollResponse) GetGatewayDelay() int64 {
 	return 0
 }
 
+// Client -> Gateway subscription to round and NDF updates
+type RoundSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partial *NDFHash `protobuf:"bytes,1,opt,name=Partial,proto3" json:"Partial,omitempty"`
+	// Only updates with a later update ID are sent
+	LastUpdate    uint64 `protobuf:"varint,2,opt,name=LastUpdate,proto3" json:"LastUpdate,omitempty"`
+	ClientVersion []byte `protobuf:"bytes,3,opt,name=ClientVersion,proto3" json:"ClientVersion,omitempty"`
+	// If true, only updates relevant to the client (FAILED, COMPLETED,
+	// QUEUED) are sent, as with GatewayPoll.FastPolling
+	FastPolling bool `protobuf:"varint,4,opt,name=FastPolling,proto3" json:"FastPolling,omitempty"`
+}
+
+func (x *RoundSubscription) Reset() {
+	*x = RoundSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundSubscription) ProtoMessage() {}
+
+func (x *RoundSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundSubscription.ProtoReflect.Descriptor instead.
+func (*RoundSubscription) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RoundSubscription) GetPartial() *NDFHash {
+	if x != nil {
+		return x.Partial
+	}
+	return nil
+}
+
+func (x *RoundSubscription) GetLastUpdate() uint64 {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return 0
+}
+
+func (x *RoundSubscription) GetClientVersion() []byte {
+	if x != nil {
+		return x.ClientVersion
+	}
+	return nil
+}
+
+func (x *RoundSubscription) GetFastPolling() bool {
+	if x != nil {
+		return x.FastPolling
+	}
+	return false
+}
+
+// Update streamed to a RoundSubscription
+type RoundSubscriptionUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PartialNDF *NDF         `protobuf:"bytes,1,opt,name=PartialNDF,proto3" json:"PartialNDF,omitempty"` // Empty if the NDF has not changed
+	Updates    []*RoundInfo `protobuf:"bytes,2,rep,name=Updates,proto3" json:"Updates,omitempty"`
+}
+
+func (x *RoundSubscriptionUpdate) Reset() {
+	*x = RoundSubscriptionUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoundSubscriptionUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundSubscriptionUpdate) ProtoMessage() {}
+
+func (x *RoundSubscriptionUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundSubscriptionUpdate.ProtoReflect.Descriptor instead.
+func (*RoundSubscriptionUpdate) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RoundSubscriptionUpdate) GetPartialNDF() *NDF {
+	if x != nil {
+		return x.PartialNDF
+	}
+	return nil
+}
+
+func (x *RoundSubscriptionUpdate) GetUpdates() []*RoundInfo {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
 // Holds a set of ClientBloom and their associated metadata
 type ClientBlooms struct {
 	state         protoimpl.MessageState
@@ -2207,7 +2538,7 @@ type ClientBlooms struct {
 func (x *ClientBlooms) Reset() {
 	*x = ClientBlooms{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[32]
+		mi := &file_mixmessages_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2220,7 +2551,7 @@ func (x *ClientBlooms) String() string {
 func (*ClientBlooms) ProtoMessage() {}
 
 func (x *ClientBlooms) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[32]
+	mi := &file_mixmessages_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,7 +2564,7 @@ func (x *ClientBlooms) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientBlooms.ProtoReflect.Descriptor instead.
 func (*ClientBlooms) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{32}
+	return file_mixmessages_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ClientBlooms) GetPeriod() int64 {
@@ -2271,7 +2602,7 @@ type ClientBloom struct {
 func (x *ClientBloom) Reset() {
 	*x = ClientBloom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[33]
+		mi := &file_mixmessages_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2284,7 +2615,7 @@ func (x *ClientBloom) String() string {
 func (*ClientBloom) ProtoMessage() {}
 
 func (x *ClientBloom) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[33]
+	mi := &file_mixmessages_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2297,7 +2628,7 @@ func (x *ClientBloom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientBloom.ProtoReflect.Descriptor instead.
 func (*ClientBloom) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{33}
+	return file_mixmessages_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ClientBloom) GetFilter() []byte {
@@ -2337,7 +2668,7 @@ type GatewaySlots struct {
 func (x *GatewaySlots) Reset() {
 	*x = GatewaySlots{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[34]
+		mi := &file_mixmessages_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2350,7 +2681,7 @@ func (x *GatewaySlots) String() string {
 func (*GatewaySlots) ProtoMessage() {}
 
 func (x *GatewaySlots) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[34]
+	mi := &file_mixmessages_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,7 +2694,7 @@ func (x *GatewaySlots) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlots.ProtoReflect.Descriptor instead.
 func (*GatewaySlots) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{34}
+	return file_mixmessages_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GatewaySlots) GetMessages() []*GatewaySlot {
@@ -2410,7 +2741,7 @@ type GatewaySlot struct {
 func (x *GatewaySlot) Reset() {
 	*x = GatewaySlot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[35]
+		mi := &file_mixmessages_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2423,7 +2754,7 @@ func (x *GatewaySlot) String() string {
 func (*GatewaySlot) ProtoMessage() {}
 
 func (x *GatewaySlot) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[35]
+	mi := &file_mixmessages_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,7 +2767,7 @@ func (x *GatewaySlot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlot.ProtoReflect.Descriptor instead.
 func (*GatewaySlot) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{35}
+	return file_mixmessages_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GatewaySlot) GetMessage() *Slot {
@@ -2480,14 +2811,20 @@ type GatewaySlotResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// True if the message or batch was processed. For PutManyMessages,
+	// individual slots may still have been rejected; see SlotResults.
 	Accepted bool   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
 	RoundID  uint64 `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	// For PutManyMessages, the result of each slot in the order of
+	// GatewaySlots.Messages. Empty if the gateway does not report per slot
+	// results.
+	SlotResults []*GatewaySlotResult `protobuf:"bytes,3,rep,name=SlotResults,proto3" json:"SlotResults,omitempty"`
 }
 
 func (x *GatewaySlotResponse) Reset() {
 	*x = GatewaySlotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[36]
+		mi := &file_mixmessages_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2500,7 +2837,7 @@ func (x *GatewaySlotResponse) String() string {
 func (*GatewaySlotResponse) ProtoMessage() {}
 
 func (x *GatewaySlotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[36]
+	mi := &file_mixmessages_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,7 +2850,7 @@ func (x *GatewaySlotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewaySlotResponse.ProtoReflect.Descriptor instead.
 func (*GatewaySlotResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{36}
+	return file_mixmessages_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GatewaySlotResponse) GetAccepted() bool {
@@ -2530,34 +2867,42 @@ func (x *GatewaySlotResponse) GetRoundID() uint64 {
 	return 0
 }
 
-// Gateway -> Gateway gossip of all Sender IDs in a Batch
-type BatchSenders struct {
+func (x *GatewaySlotResponse) GetSlotResults() []*GatewaySlotResult {
+	if x != nil {
+		return x.SlotResults
+	}
+	return nil
+}
+
+// GatewaySlotResult is the outcome of a single slot in a GatewaySlots batch.
+type GatewaySlotResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SenderIds [][]byte `protobuf:"bytes,1,rep,name=SenderIds,proto3" json:"SenderIds,omitempty"`
-	RoundID   uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
-	Ips       [][]byte `protobuf:"bytes,3,rep,name=Ips,proto3" json:"Ips,omitempty"`
+	Status GatewaySlotStatus    `protobuf:"varint,1,opt,name=Status,proto3,enum=mixmessages.GatewaySlotStatus" json:"Status,omitempty"`
+	Reason GatewaySlotRejection `protobuf:"varint,2,opt,name=Reason,proto3,enum=mixmessages.GatewaySlotRejection" json:"Reason,omitempty"`
+	// The round the slot was assigned to, if accepted
+	RoundID uint64 `protobuf:"varint,3,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
 }
 
-func (x *BatchSenders) Reset() {
-	*x = BatchSenders{}
+func (x *GatewaySlotResult) Reset() {
+	*x = GatewaySlotResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[37]
+		mi := &file_mixmessages_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchSenders) String() string {
+func (x *GatewaySlotResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchSenders) ProtoMessage() {}
+func (*GatewaySlotResult) ProtoMessage() {}
 
-func (x *BatchSenders) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[37]
+func (x *GatewaySlotResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,47 +2913,111 @@ func (x *BatchSenders) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchSenders.ProtoReflect.Descriptor instead.
-func (*BatchSenders) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GatewaySlotResult.ProtoReflect.Descriptor instead.
+func (*GatewaySlotResult) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *BatchSenders) GetSenderIds() [][]byte {
+func (x *GatewaySlotResult) GetStatus() GatewaySlotStatus {
 	if x != nil {
-		return x.SenderIds
+		return x.Status
 	}
-	return nil
+	return GatewaySlotStatus_SLOT_STATUS_UNKNOWN
 }
 
-func (x *BatchSenders) GetRoundID() uint64 {
+func (x *GatewaySlotResult) GetReason() GatewaySlotRejection {
 	if x != nil {
-		return x.RoundID
+		return x.Reason
 	}
-	return 0
+	return GatewaySlotRejection_SLOT_REJECTION_NONE
 }
 
-func (x *BatchSenders) GetIps() [][]byte {
+func (x *GatewaySlotResult) GetRoundID() uint64 {
 	if x != nil {
-		return x.Ips
+		return x.RoundID
 	}
-	return nil
+	return 0
 }
 
-// Gateway -> Gateway gossip of all recipient IDs in a Batch
-type Recipients struct {
+// Gateway -> Gateway gossip of all Sender IDs in a Batch
+type BatchSenders struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecipientIds [][]byte `protobuf:"bytes,1,rep,name=RecipientIds,proto3" json:"RecipientIds,omitempty"`
-	RoundID      uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
-	RoundTS      uint64   `protobuf:"varint,3,opt,name=RoundTS,proto3" json:"RoundTS,omitempty"`
+	SenderIds [][]byte `protobuf:"bytes,1,rep,name=SenderIds,proto3" json:"SenderIds,omitempty"`
+	RoundID   uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	Ips       [][]byte `protobuf:"bytes,3,rep,name=Ips,proto3" json:"Ips,omitempty"`
+}
+
+func (x *BatchSenders) Reset() {
+	*x = BatchSenders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSenders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSenders) ProtoMessage() {}
+
+func (x *BatchSenders) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSenders.ProtoReflect.Descriptor instead.
+func (*BatchSenders) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchSenders) GetSenderIds() [][]byte {
+	if x != nil {
+		return x.SenderIds
+	}
+	return nil
+}
+
+func (x *BatchSenders) GetRoundID() uint64 {
+	if x != nil {
+		return x.RoundID
+	}
+	return 0
+}
+
+func (x *BatchSenders) GetIps() [][]byte {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+// Gateway -> Gateway gossip of all recipient IDs in a Batch
+type Recipients struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecipientIds [][]byte `protobuf:"bytes,1,rep,name=RecipientIds,proto3" json:"RecipientIds,omitempty"`
+	RoundID      uint64   `protobuf:"varint,2,opt,name=RoundID,proto3" json:"RoundID,omitempty"`
+	RoundTS      uint64   `protobuf:"varint,3,opt,name=RoundTS,proto3" json:"RoundTS,omitempty"`
 }
 
 func (x *Recipients) Reset() {
 	*x = Recipients{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[38]
+		mi := &file_mixmessages_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2621,7 +3030,7 @@ func (x *Recipients) String() string {
 func (*Recipients) ProtoMessage() {}
 
 func (x *Recipients) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[38]
+	mi := &file_mixmessages_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,7 +3043,7 @@ func (x *Recipients) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Recipients.ProtoReflect.Descriptor instead.
 func (*Recipients) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{38}
+	return file_mixmessages_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Recipients) GetRecipientIds() [][]byte {
@@ -2670,7 +3079,7 @@ type RegisteredNodeConfirmation struct {
 func (x *RegisteredNodeConfirmation) Reset() {
 	*x = RegisteredNodeConfirmation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[39]
+		mi := &file_mixmessages_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2683,7 +3092,7 @@ func (x *RegisteredNodeConfirmation) String() string {
 func (*RegisteredNodeConfirmation) ProtoMessage() {}
 
 func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[39]
+	mi := &file_mixmessages_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2696,7 +3105,7 @@ func (x *RegisteredNodeConfirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredNodeConfirmation.ProtoReflect.Descriptor instead.
 func (*RegisteredNodeConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{39}
+	return file_mixmessages_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RegisteredNodeConfirmation) GetIsRegistered() bool {
@@ -2717,7 +3126,7 @@ type RegisteredNodeCheck struct {
 func (x *RegisteredNodeCheck) Reset() {
 	*x = RegisteredNodeCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[40]
+		mi := &file_mixmessages_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2730,7 +3139,7 @@ func (x *RegisteredNodeCheck) String() string {
 func (*RegisteredNodeCheck) ProtoMessage() {}
 
 func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[40]
+	mi := &file_mixmessages_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2743,7 +3152,7 @@ func (x *RegisteredNodeCheck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisteredNodeCheck.ProtoReflect.Descriptor instead.
 func (*RegisteredNodeCheck) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{40}
+	return file_mixmessages_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *RegisteredNodeCheck) GetID() []byte {
@@ -2765,7 +3174,7 @@ type NDFHash struct {
 func (x *NDFHash) Reset() {
 	*x = NDFHash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[41]
+		mi := &file_mixmessages_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2778,7 +3187,7 @@ func (x *NDFHash) String() string {
 func (*NDFHash) ProtoMessage() {}
 
 func (x *NDFHash) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[41]
+	mi := &file_mixmessages_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +3200,7 @@ func (x *NDFHash) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NDFHash.ProtoReflect.Descriptor instead.
 func (*NDFHash) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{41}
+	return file_mixmessages_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *NDFHash) GetHash() []byte {
@@ -2817,7 +3226,7 @@ type NDF struct {
 func (x *NDF) Reset() {
 	*x = NDF{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[42]
+		mi := &file_mixmessages_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2830,7 +3239,7 @@ func (x *NDF) String() string {
 func (*NDF) ProtoMessage() {}
 
 func (x *NDF) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[42]
+	mi := &file_mixmessages_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,7 +3252,7 @@ func (x *NDF) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NDF.ProtoReflect.Descriptor instead.
 func (*NDF) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{42}
+	return file_mixmessages_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *NDF) GetNdf() []byte {
@@ -2882,7 +3291,7 @@ type NodeRegistration struct {
 func (x *NodeRegistration) Reset() {
 	*x = NodeRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[43]
+		mi := &file_mixmessages_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2895,7 +3304,7 @@ func (x *NodeRegistration) String() string {
 func (*NodeRegistration) ProtoMessage() {}
 
 func (x *NodeRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[43]
+	mi := &file_mixmessages_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2908,7 +3317,7 @@ func (x *NodeRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeRegistration.ProtoReflect.Descriptor instead.
 func (*NodeRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{43}
+	return file_mixmessages_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *NodeRegistration) GetSalt() []byte {
@@ -2982,7 +3391,7 @@ type ClientRegistration struct {
 func (x *ClientRegistration) Reset() {
 	*x = ClientRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[44]
+		mi := &file_mixmessages_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2995,7 +3404,7 @@ func (x *ClientRegistration) String() string {
 func (*ClientRegistration) ProtoMessage() {}
 
 func (x *ClientRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[44]
+	mi := &file_mixmessages_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3008,7 +3417,7 @@ func (x *ClientRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientRegistration.ProtoReflect.Descriptor instead.
 func (*ClientRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{44}
+	return file_mixmessages_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ClientRegistration) GetRegistrationCode() string {
@@ -3046,7 +3455,7 @@ type ClientRegistrationConfirmation struct {
 func (x *ClientRegistrationConfirmation) Reset() {
 	*x = ClientRegistrationConfirmation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[45]
+		mi := &file_mixmessages_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3059,7 +3468,7 @@ func (x *ClientRegistrationConfirmation) String() string {
 func (*ClientRegistrationConfirmation) ProtoMessage() {}
 
 func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[45]
+	mi := &file_mixmessages_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3072,7 +3481,7 @@ func (x *ClientRegistrationConfirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientRegistrationConfirmation.ProtoReflect.Descriptor instead.
 func (*ClientRegistrationConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{45}
+	return file_mixmessages_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ClientRegistrationConfirmation) GetRSAPubKey() string {
@@ -3101,7 +3510,7 @@ type SignedRegistrationConfirmation struct {
 func (x *SignedRegistrationConfirmation) Reset() {
 	*x = SignedRegistrationConfirmation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[46]
+		mi := &file_mixmessages_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3114,7 +3523,7 @@ func (x *SignedRegistrationConfirmation) String() string {
 func (*SignedRegistrationConfirmation) ProtoMessage() {}
 
 func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[46]
+	mi := &file_mixmessages_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3127,7 +3536,7 @@ func (x *SignedRegistrationConfirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignedRegistrationConfirmation.ProtoReflect.Descriptor instead.
 func (*SignedRegistrationConfirmation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{46}
+	return file_mixmessages_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SignedRegistrationConfirmation) GetClientRegistrationConfirmation() []byte {
@@ -3157,7 +3566,7 @@ type SignedClientRegistrationConfirmations struct {
 func (x *SignedClientRegistrationConfirmations) Reset() {
 	*x = SignedClientRegistrationConfirmations{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[47]
+		mi := &file_mixmessages_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3170,7 +3579,7 @@ func (x *SignedClientRegistrationConfirmations) String() string {
 func (*SignedClientRegistrationConfirmations) ProtoMessage() {}
 
 func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[47]
+	mi := &file_mixmessages_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3183,7 +3592,7 @@ func (x *SignedClientRegistrationConfirmations) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use SignedClientRegistrationConfirmations.ProtoReflect.Descriptor instead.
 func (*SignedClientRegistrationConfirmations) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{47}
+	return file_mixmessages_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SignedClientRegistrationConfirmations) GetClientTransmissionConfirmation() *SignedRegistrationConfirmation {
@@ -3219,7 +3628,7 @@ type ClientVersion struct {
 func (x *ClientVersion) Reset() {
 	*x = ClientVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[48]
+		mi := &file_mixmessages_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3232,7 +3641,7 @@ func (x *ClientVersion) String() string {
 func (*ClientVersion) ProtoMessage() {}
 
 func (x *ClientVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[48]
+	mi := &file_mixmessages_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3245,7 +3654,7 @@ func (x *ClientVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientVersion.ProtoReflect.Descriptor instead.
 func (*ClientVersion) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{48}
+	return file_mixmessages_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ClientVersion) GetVersion() string {
@@ -3277,7 +3686,7 @@ type PermissioningPoll struct {
 func (x *PermissioningPoll) Reset() {
 	*x = PermissioningPoll{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[49]
+		mi := &file_mixmessages_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3290,7 +3699,7 @@ func (x *PermissioningPoll) String() string {
 func (*PermissioningPoll) ProtoMessage() {}
 
 func (x *PermissioningPoll) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[49]
+	mi := &file_mixmessages_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,7 +3712,7 @@ func (x *PermissioningPoll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissioningPoll.ProtoReflect.Descriptor instead.
 func (*PermissioningPoll) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{49}
+	return file_mixmessages_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *PermissioningPoll) GetFull() *NDFHash {
@@ -3398,7 +3807,7 @@ type ClientError struct {
 func (x *ClientError) Reset() {
 	*x = ClientError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[50]
+		mi := &file_mixmessages_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3411,7 +3820,7 @@ func (x *ClientError) String() string {
 func (*ClientError) ProtoMessage() {}
 
 func (x *ClientError) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[50]
+	mi := &file_mixmessages_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3424,7 +3833,7 @@ func (x *ClientError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClientError.ProtoReflect.Descriptor instead.
 func (*ClientError) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{50}
+	return file_mixmessages_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ClientError) GetClientId() []byte {
@@ -3466,7 +3875,7 @@ type PermissionPollResponse struct {
 func (x *PermissionPollResponse) Reset() {
 	*x = PermissionPollResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[51]
+		mi := &file_mixmessages_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3479,7 +3888,7 @@ func (x *PermissionPollResponse) String() string {
 func (*PermissionPollResponse) ProtoMessage() {}
 
 func (x *PermissionPollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[51]
+	mi := &file_mixmessages_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3492,7 +3901,7 @@ func (x *PermissionPollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionPollResponse.ProtoReflect.Descriptor instead.
 func (*PermissionPollResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{51}
+	return file_mixmessages_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *PermissionPollResponse) GetFullNDF() *NDF {
@@ -3562,7 +3971,7 @@ type RegisterTokenRequest struct {
 func (x *RegisterTokenRequest) Reset() {
 	*x = RegisterTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[52]
+		mi := &file_mixmessages_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3575,7 +3984,7 @@ func (x *RegisterTokenRequest) String() string {
 func (*RegisterTokenRequest) ProtoMessage() {}
 
 func (x *RegisterTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[52]
+	mi := &file_mixmessages_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3588,7 +3997,7 @@ func (x *RegisterTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTokenRequest.ProtoReflect.Descriptor instead.
 func (*RegisterTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{52}
+	return file_mixmessages_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *RegisterTokenRequest) GetApp() string {
@@ -3662,7 +4071,7 @@ type UnregisterTokenRequest struct {
 func (x *UnregisterTokenRequest) Reset() {
 	*x = UnregisterTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[53]
+		mi := &file_mixmessages_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3675,7 +4084,7 @@ func (x *UnregisterTokenRequest) String() string {
 func (*UnregisterTokenRequest) ProtoMessage() {}
 
 func (x *UnregisterTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[53]
+	mi := &file_mixmessages_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3688,7 +4097,7 @@ func (x *UnregisterTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterTokenRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterTokenRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{53}
+	return file_mixmessages_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *UnregisterTokenRequest) GetApp() string {
@@ -3737,7 +4146,7 @@ type UnregisterTrackedIdRequest struct {
 func (x *UnregisterTrackedIdRequest) Reset() {
 	*x = UnregisterTrackedIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[54]
+		mi := &file_mixmessages_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3750,7 +4159,7 @@ func (x *UnregisterTrackedIdRequest) String() string {
 func (*UnregisterTrackedIdRequest) ProtoMessage() {}
 
 func (x *UnregisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[54]
+	mi := &file_mixmessages_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3763,7 +4172,7 @@ func (x *UnregisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnregisterTrackedIdRequest.ProtoReflect.Descriptor instead.
 func (*UnregisterTrackedIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{54}
+	return file_mixmessages_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *UnregisterTrackedIdRequest) GetRequest() *TrackedIntermediaryIdRequest {
@@ -3786,7 +4195,7 @@ type RegisterTrackedIdRequest struct {
 func (x *RegisterTrackedIdRequest) Reset() {
 	*x = RegisterTrackedIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[55]
+		mi := &file_mixmessages_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3799,7 +4208,7 @@ func (x *RegisterTrackedIdRequest) String() string {
 func (*RegisterTrackedIdRequest) ProtoMessage() {}
 
 func (x *RegisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[55]
+	mi := &file_mixmessages_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3812,7 +4221,7 @@ func (x *RegisterTrackedIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterTrackedIdRequest.ProtoReflect.Descriptor instead.
 func (*RegisterTrackedIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{55}
+	return file_mixmessages_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *RegisterTrackedIdRequest) GetRequest() *TrackedIntermediaryIdRequest {
@@ -3850,7 +4259,7 @@ type TrackedIntermediaryIdRequest struct {
 func (x *TrackedIntermediaryIdRequest) Reset() {
 	*x = TrackedIntermediaryIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[56]
+		mi := &file_mixmessages_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3863,7 +4272,7 @@ func (x *TrackedIntermediaryIdRequest) String() string {
 func (*TrackedIntermediaryIdRequest) ProtoMessage() {}
 
 func (x *TrackedIntermediaryIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[56]
+	mi := &file_mixmessages_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,7 +4285,7 @@ func (x *TrackedIntermediaryIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackedIntermediaryIdRequest.ProtoReflect.Descriptor instead.
 func (*TrackedIntermediaryIdRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{56}
+	return file_mixmessages_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *TrackedIntermediaryIdRequest) GetTrackedIntermediaryID() [][]byte {
@@ -3926,7 +4335,7 @@ type NotificationRegisterRequest struct {
 func (x *NotificationRegisterRequest) Reset() {
 	*x = NotificationRegisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[57]
+		mi := &file_mixmessages_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3939,7 +4348,7 @@ func (x *NotificationRegisterRequest) String() string {
 func (*NotificationRegisterRequest) ProtoMessage() {}
 
 func (x *NotificationRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[57]
+	mi := &file_mixmessages_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3952,7 +4361,7 @@ func (x *NotificationRegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationRegisterRequest.ProtoReflect.Descriptor instead.
 func (*NotificationRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{57}
+	return file_mixmessages_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *NotificationRegisterRequest) GetToken() string {
@@ -4016,7 +4425,7 @@ type NotificationUnregisterRequest struct {
 func (x *NotificationUnregisterRequest) Reset() {
 	*x = NotificationUnregisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[58]
+		mi := &file_mixmessages_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4029,7 +4438,7 @@ func (x *NotificationUnregisterRequest) String() string {
 func (*NotificationUnregisterRequest) ProtoMessage() {}
 
 func (x *NotificationUnregisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[58]
+	mi := &file_mixmessages_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4042,7 +4451,7 @@ func (x *NotificationUnregisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationUnregisterRequest.ProtoReflect.Descriptor instead.
 func (*NotificationUnregisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{58}
+	return file_mixmessages_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *NotificationUnregisterRequest) GetIntermediaryId() []byte {
@@ -4071,7 +4480,7 @@ type UserIdList struct {
 func (x *UserIdList) Reset() {
 	*x = UserIdList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[59]
+		mi := &file_mixmessages_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4084,7 +4493,7 @@ func (x *UserIdList) String() string {
 func (*UserIdList) ProtoMessage() {}
 
 func (x *UserIdList) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[59]
+	mi := &file_mixmessages_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4097,7 +4506,7 @@ func (x *UserIdList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserIdList.ProtoReflect.Descriptor instead.
 func (*UserIdList) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{59}
+	return file_mixmessages_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *UserIdList) GetIDs() [][]byte {
@@ -4120,7 +4529,7 @@ type NotificationBatch struct {
 func (x *NotificationBatch) Reset() {
 	*x = NotificationBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[60]
+		mi := &file_mixmessages_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4133,7 +4542,7 @@ func (x *NotificationBatch) String() string {
 func (*NotificationBatch) ProtoMessage() {}
 
 func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[60]
+	mi := &file_mixmessages_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4146,7 +4555,7 @@ func (x *NotificationBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationBatch.ProtoReflect.Descriptor instead.
 func (*NotificationBatch) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{60}
+	return file_mixmessages_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *NotificationBatch) GetRoundID() uint64 {
@@ -4177,7 +4586,7 @@ type NotificationData struct {
 func (x *NotificationData) Reset() {
 	*x = NotificationData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[61]
+		mi := &file_mixmessages_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4190,7 +4599,7 @@ func (x *NotificationData) String() string {
 func (*NotificationData) ProtoMessage() {}
 
 func (x *NotificationData) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[61]
+	mi := &file_mixmessages_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4203,7 +4612,7 @@ func (x *NotificationData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationData.ProtoReflect.Descriptor instead.
 func (*NotificationData) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{61}
+	return file_mixmessages_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *NotificationData) GetEphemeralID() int64 {
@@ -4242,7 +4651,7 @@ type ChannelLeaseRequest struct {
 func (x *ChannelLeaseRequest) Reset() {
 	*x = ChannelLeaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[62]
+		mi := &file_mixmessages_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4255,7 +4664,7 @@ func (x *ChannelLeaseRequest) String() string {
 func (*ChannelLeaseRequest) ProtoMessage() {}
 
 func (x *ChannelLeaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[62]
+	mi := &file_mixmessages_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4268,7 +4677,7 @@ func (x *ChannelLeaseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelLeaseRequest.ProtoReflect.Descriptor instead.
 func (*ChannelLeaseRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{62}
+	return file_mixmessages_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *ChannelLeaseRequest) GetUserID() []byte {
@@ -4313,7 +4722,7 @@ type ChannelLeaseResponse struct {
 func (x *ChannelLeaseResponse) Reset() {
 	*x = ChannelLeaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[63]
+		mi := &file_mixmessages_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4326,7 +4735,7 @@ func (x *ChannelLeaseResponse) String() string {
 func (*ChannelLeaseResponse) ProtoMessage() {}
 
 func (x *ChannelLeaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[63]
+	mi := &file_mixmessages_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4339,7 +4748,7 @@ func (x *ChannelLeaseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelLeaseResponse.ProtoReflect.Descriptor instead.
 func (*ChannelLeaseResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{63}
+	return file_mixmessages_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *ChannelLeaseResponse) GetLease() int64 {
@@ -4376,7 +4785,7 @@ type UsernameValidationRequest struct {
 func (x *UsernameValidationRequest) Reset() {
 	*x = UsernameValidationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[64]
+		mi := &file_mixmessages_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4389,7 +4798,7 @@ func (x *UsernameValidationRequest) String() string {
 func (*UsernameValidationRequest) ProtoMessage() {}
 
 func (x *UsernameValidationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[64]
+	mi := &file_mixmessages_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4402,7 +4811,7 @@ func (x *UsernameValidationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameValidationRequest.ProtoReflect.Descriptor instead.
 func (*UsernameValidationRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{64}
+	return file_mixmessages_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *UsernameValidationRequest) GetUserId() []byte {
@@ -4427,7 +4836,7 @@ type UsernameValidation struct {
 func (x *UsernameValidation) Reset() {
 	*x = UsernameValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[65]
+		mi := &file_mixmessages_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4440,7 +4849,7 @@ func (x *UsernameValidation) String() string {
 func (*UsernameValidation) ProtoMessage() {}
 
 func (x *UsernameValidation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[65]
+	mi := &file_mixmessages_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4453,7 +4862,7 @@ func (x *UsernameValidation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameValidation.ProtoReflect.Descriptor instead.
 func (*UsernameValidation) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{65}
+	return file_mixmessages_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UsernameValidation) GetSignature() []byte {
@@ -4495,7 +4904,7 @@ type UDBUserRegistration struct {
 func (x *UDBUserRegistration) Reset() {
 	*x = UDBUserRegistration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[66]
+		mi := &file_mixmessages_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4508,7 +4917,7 @@ func (x *UDBUserRegistration) String() string {
 func (*UDBUserRegistration) ProtoMessage() {}
 
 func (x *UDBUserRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[66]
+	mi := &file_mixmessages_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4521,7 +4930,7 @@ func (x *UDBUserRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UDBUserRegistration.ProtoReflect.Descriptor instead.
 func (*UDBUserRegistration) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{66}
+	return file_mixmessages_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *UDBUserRegistration) GetPermissioningSignature() []byte {
@@ -4587,7 +4996,7 @@ type Identity struct {
 func (x *Identity) Reset() {
 	*x = Identity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[67]
+		mi := &file_mixmessages_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4600,7 +5009,7 @@ func (x *Identity) String() string {
 func (*Identity) ProtoMessage() {}
 
 func (x *Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[67]
+	mi := &file_mixmessages_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4613,7 +5022,7 @@ func (x *Identity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Identity.ProtoReflect.Descriptor instead.
 func (*Identity) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{67}
+	return file_mixmessages_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Identity) GetUsername() string {
@@ -4651,7 +5060,7 @@ type FactRegisterRequest struct {
 func (x *FactRegisterRequest) Reset() {
 	*x = FactRegisterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[68]
+		mi := &file_mixmessages_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4664,7 +5073,7 @@ func (x *FactRegisterRequest) String() string {
 func (*FactRegisterRequest) ProtoMessage() {}
 
 func (x *FactRegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[68]
+	mi := &file_mixmessages_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4677,7 +5086,7 @@ func (x *FactRegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactRegisterRequest.ProtoReflect.Descriptor instead.
 func (*FactRegisterRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{68}
+	return file_mixmessages_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *FactRegisterRequest) GetUID() []byte {
@@ -4714,7 +5123,7 @@ type Fact struct {
 func (x *Fact) Reset() {
 	*x = Fact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[69]
+		mi := &file_mixmessages_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4727,7 +5136,7 @@ func (x *Fact) String() string {
 func (*Fact) ProtoMessage() {}
 
 func (x *Fact) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[69]
+	mi := &file_mixmessages_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4740,7 +5149,7 @@ func (x *Fact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Fact.ProtoReflect.Descriptor instead.
 func (*Fact) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{69}
+	return file_mixmessages_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *Fact) GetFact() string {
@@ -4769,7 +5178,7 @@ type FactRegisterResponse struct {
 func (x *FactRegisterResponse) Reset() {
 	*x = FactRegisterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[70]
+		mi := &file_mixmessages_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4782,7 +5191,7 @@ func (x *FactRegisterResponse) String() string {
 func (*FactRegisterResponse) ProtoMessage() {}
 
 func (x *FactRegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[70]
+	mi := &file_mixmessages_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4795,7 +5204,7 @@ func (x *FactRegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactRegisterResponse.ProtoReflect.Descriptor instead.
 func (*FactRegisterResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{70}
+	return file_mixmessages_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *FactRegisterResponse) GetConfirmationID() string {
@@ -4818,7 +5227,7 @@ type FactConfirmRequest struct {
 func (x *FactConfirmRequest) Reset() {
 	*x = FactConfirmRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[71]
+		mi := &file_mixmessages_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4831,7 +5240,7 @@ func (x *FactConfirmRequest) String() string {
 func (*FactConfirmRequest) ProtoMessage() {}
 
 func (x *FactConfirmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[71]
+	mi := &file_mixmessages_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4844,7 +5253,7 @@ func (x *FactConfirmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactConfirmRequest.ProtoReflect.Descriptor instead.
 func (*FactConfirmRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{71}
+	return file_mixmessages_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *FactConfirmRequest) GetConfirmationID() string {
@@ -4875,7 +5284,7 @@ type FactRemovalRequest struct {
 func (x *FactRemovalRequest) Reset() {
 	*x = FactRemovalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[72]
+		mi := &file_mixmessages_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4888,7 +5297,7 @@ func (x *FactRemovalRequest) String() string {
 func (*FactRemovalRequest) ProtoMessage() {}
 
 func (x *FactRemovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[72]
+	mi := &file_mixmessages_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4901,7 +5310,7 @@ func (x *FactRemovalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FactRemovalRequest.ProtoReflect.Descriptor instead.
 func (*FactRemovalRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{72}
+	return file_mixmessages_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *FactRemovalRequest) GetUID() []byte {
@@ -4937,7 +5346,7 @@ type StrAddress struct {
 func (x *StrAddress) Reset() {
 	*x = StrAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[73]
+		mi := &file_mixmessages_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4950,7 +5359,7 @@ func (x *StrAddress) String() string {
 func (*StrAddress) ProtoMessage() {}
 
 func (x *StrAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[73]
+	mi := &file_mixmessages_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4963,7 +5372,7 @@ func (x *StrAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StrAddress.ProtoReflect.Descriptor instead.
 func (*StrAddress) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{73}
+	return file_mixmessages_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *StrAddress) GetAddress() string {
@@ -4996,7 +5405,7 @@ type RoundInfo struct {
 func (x *RoundInfo) Reset() {
 	*x = RoundInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[74]
+		mi := &file_mixmessages_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5009,7 +5418,7 @@ func (x *RoundInfo) String() string {
 func (*RoundInfo) ProtoMessage() {}
 
 func (x *RoundInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[74]
+	mi := &file_mixmessages_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5022,7 +5431,7 @@ func (x *RoundInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundInfo.ProtoReflect.Descriptor instead.
 func (*RoundInfo) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{74}
+	return file_mixmessages_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *RoundInfo) GetID() uint64 {
@@ -5125,7 +5534,7 @@ type RoundError struct {
 func (x *RoundError) Reset() {
 	*x = RoundError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[75]
+		mi := &file_mixmessages_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5138,7 +5547,7 @@ func (x *RoundError) String() string {
 func (*RoundError) ProtoMessage() {}
 
 func (x *RoundError) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[75]
+	mi := &file_mixmessages_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5151,7 +5560,7 @@ func (x *RoundError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoundError.ProtoReflect.Descriptor instead.
 func (*RoundError) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{75}
+	return file_mixmessages_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *RoundError) GetId() uint64 {
@@ -5191,7 +5600,7 @@ type EABCredentialRequest struct {
 func (x *EABCredentialRequest) Reset() {
 	*x = EABCredentialRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[76]
+		mi := &file_mixmessages_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5204,7 +5613,7 @@ func (x *EABCredentialRequest) String() string {
 func (*EABCredentialRequest) ProtoMessage() {}
 
 func (x *EABCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[76]
+	mi := &file_mixmessages_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5217,7 +5626,7 @@ func (x *EABCredentialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EABCredentialRequest.ProtoReflect.Descriptor instead.
 func (*EABCredentialRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{76}
+	return file_mixmessages_proto_rawDescGZIP(), []int{79}
 }
 
 type EABCredentialResponse struct {
@@ -5232,7 +5641,7 @@ type EABCredentialResponse struct {
 func (x *EABCredentialResponse) Reset() {
 	*x = EABCredentialResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[77]
+		mi := &file_mixmessages_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5245,7 +5654,7 @@ func (x *EABCredentialResponse) String() string {
 func (*EABCredentialResponse) ProtoMessage() {}
 
 func (x *EABCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[77]
+	mi := &file_mixmessages_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5258,7 +5667,7 @@ func (x *EABCredentialResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EABCredentialResponse.ProtoReflect.Descriptor instead.
 func (*EABCredentialResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{77}
+	return file_mixmessages_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *EABCredentialResponse) GetKeyId() string {
@@ -5289,7 +5698,7 @@ type AuthorizerCertRequest struct {
 func (x *AuthorizerCertRequest) Reset() {
 	*x = AuthorizerCertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[78]
+		mi := &file_mixmessages_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5302,7 +5711,7 @@ func (x *AuthorizerCertRequest) String() string {
 func (*AuthorizerCertRequest) ProtoMessage() {}
 
 func (x *AuthorizerCertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[78]
+	mi := &file_mixmessages_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5315,7 +5724,7 @@ func (x *AuthorizerCertRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizerCertRequest.ProtoReflect.Descriptor instead.
 func (*AuthorizerCertRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{78}
+	return file_mixmessages_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *AuthorizerCertRequest) GetGwID() []byte {
@@ -5361,7 +5770,7 @@ type AuthorizerAuth struct {
 func (x *AuthorizerAuth) Reset() {
 	*x = AuthorizerAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[79]
+		mi := &file_mixmessages_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5374,7 +5783,7 @@ func (x *AuthorizerAuth) String() string {
 func (*AuthorizerAuth) ProtoMessage() {}
 
 func (x *AuthorizerAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[79]
+	mi := &file_mixmessages_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5387,7 +5796,7 @@ func (x *AuthorizerAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthorizerAuth.ProtoReflect.Descriptor instead.
 func (*AuthorizerAuth) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{79}
+	return file_mixmessages_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *AuthorizerAuth) GetNodeID() []byte {
@@ -5438,7 +5847,7 @@ type RsAuthenticationRequest struct {
 func (x *RsAuthenticationRequest) Reset() {
 	*x = RsAuthenticationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[80]
+		mi := &file_mixmessages_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5451,7 +5860,7 @@ func (x *RsAuthenticationRequest) String() string {
 func (*RsAuthenticationRequest) ProtoMessage() {}
 
 func (x *RsAuthenticationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[80]
+	mi := &file_mixmessages_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5464,7 +5873,7 @@ func (x *RsAuthenticationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsAuthenticationRequest.ProtoReflect.Descriptor instead.
 func (*RsAuthenticationRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{80}
+	return file_mixmessages_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *RsAuthenticationRequest) GetUsername() string {
@@ -5500,7 +5909,7 @@ type RsAuthenticationResponse struct {
 func (x *RsAuthenticationResponse) Reset() {
 	*x = RsAuthenticationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[81]
+		mi := &file_mixmessages_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5513,7 +5922,7 @@ func (x *RsAuthenticationResponse) String() string {
 func (*RsAuthenticationResponse) ProtoMessage() {}
 
 func (x *RsAuthenticationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[81]
+	mi := &file_mixmessages_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5526,7 +5935,7 @@ func (x *RsAuthenticationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsAuthenticationResponse.ProtoReflect.Descriptor instead.
 func (*RsAuthenticationResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{81}
+	return file_mixmessages_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *RsAuthenticationResponse) GetToken() []byte {
@@ -5555,7 +5964,7 @@ type RsReadRequest struct {
 func (x *RsReadRequest) Reset() {
 	*x = RsReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[82]
+		mi := &file_mixmessages_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5568,7 +5977,7 @@ func (x *RsReadRequest) String() string {
 func (*RsReadRequest) ProtoMessage() {}
 
 func (x *RsReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[82]
+	mi := &file_mixmessages_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5581,7 +5990,7 @@ func (x *RsReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsReadRequest.ProtoReflect.Descriptor instead.
 func (*RsReadRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{82}
+	return file_mixmessages_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *RsReadRequest) GetPath() string {
@@ -5609,7 +6018,7 @@ type RsLastWriteRequest struct {
 func (x *RsLastWriteRequest) Reset() {
 	*x = RsLastWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[83]
+		mi := &file_mixmessages_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5622,7 +6031,7 @@ func (x *RsLastWriteRequest) String() string {
 func (*RsLastWriteRequest) ProtoMessage() {}
 
 func (x *RsLastWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[83]
+	mi := &file_mixmessages_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5635,7 +6044,7 @@ func (x *RsLastWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsLastWriteRequest.ProtoReflect.Descriptor instead.
 func (*RsLastWriteRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{83}
+	return file_mixmessages_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *RsLastWriteRequest) GetToken() []byte {
@@ -5651,12 +6060,14 @@ type RsReadResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	// Version of the path at the time it was read
+	Version int64 `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
 func (x *RsReadResponse) Reset() {
 	*x = RsReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[84]
+		mi := &file_mixmessages_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5669,7 +6080,7 @@ func (x *RsReadResponse) String() string {
 func (*RsReadResponse) ProtoMessage() {}
 
 func (x *RsReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[84]
+	mi := &file_mixmessages_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5682,7 +6093,7 @@ func (x *RsReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsReadResponse.ProtoReflect.Descriptor instead.
 func (*RsReadResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{84}
+	return file_mixmessages_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *RsReadResponse) GetData() []byte {
@@ -5692,6 +6103,13 @@ func (x *RsReadResponse) GetData() []byte {
 	return nil
 }
 
+func (x *RsReadResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type RsWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5700,12 +6118,14 @@ type RsWriteRequest struct {
 	Path  string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
 	Data  []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
 	Token []byte `protobuf:"bytes,3,opt,name=Token,proto3" json:"Token,omitempty"`
+	// Optional condition that must hold for the write to be applied
+	Precondition *RsWritePrecondition `protobuf:"bytes,4,opt,name=Precondition,proto3" json:"Precondition,omitempty"`
 }
 
 func (x *RsWriteRequest) Reset() {
 	*x = RsWriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[85]
+		mi := &file_mixmessages_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5718,7 +6138,7 @@ func (x *RsWriteRequest) String() string {
 func (*RsWriteRequest) ProtoMessage() {}
 
 func (x *RsWriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[85]
+	mi := &file_mixmessages_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5731,7 +6151,7 @@ func (x *RsWriteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsWriteRequest.ProtoReflect.Descriptor instead.
 func (*RsWriteRequest) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{85}
+	return file_mixmessages_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *RsWriteRequest) GetPath() string {
@@ -5755,6 +6175,121 @@ func (x *RsWriteRequest) GetToken() []byte {
 	return nil
 }
 
+func (x *RsWriteRequest) GetPrecondition() *RsWritePrecondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
+// RsWritePrecondition is a condition on the current state of a path that must
+// hold for a write to it to be applied.
+type RsWritePrecondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version the path must currently be at. A version of 0 requires that the
+	// path does not exist.
+	ExpectedVersion int64 `protobuf:"varint,1,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
+}
+
+func (x *RsWritePrecondition) Reset() {
+	*x = RsWritePrecondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsWritePrecondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsWritePrecondition) ProtoMessage() {}
+
+func (x *RsWritePrecondition) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsWritePrecondition.ProtoReflect.Descriptor instead.
+func (*RsWritePrecondition) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *RsWritePrecondition) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// RsWriteConflict is attached to the error returned when a write precondition
+// fails.
+type RsWriteConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path           string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	CurrentVersion int64  `protobuf:"varint,2,opt,name=CurrentVersion,proto3" json:"CurrentVersion,omitempty"`
+}
+
+func (x *RsWriteConflict) Reset() {
+	*x = RsWriteConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsWriteConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsWriteConflict) ProtoMessage() {}
+
+func (x *RsWriteConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsWriteConflict.ProtoReflect.Descriptor instead.
+func (*RsWriteConflict) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RsWriteConflict) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsWriteConflict) GetCurrentVersion() int64 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
 type RsReadDirResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5766,7 +6301,7 @@ type RsReadDirResponse struct {
 func (x *RsReadDirResponse) Reset() {
 	*x = RsReadDirResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[86]
+		mi := &file_mixmessages_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5779,7 +6314,7 @@ func (x *RsReadDirResponse) String() string {
 func (*RsReadDirResponse) ProtoMessage() {}
 
 func (x *RsReadDirResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[86]
+	mi := &file_mixmessages_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5792,7 +6327,7 @@ func (x *RsReadDirResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsReadDirResponse.ProtoReflect.Descriptor instead.
 func (*RsReadDirResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{86}
+	return file_mixmessages_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *RsReadDirResponse) GetData() []string {
@@ -5813,7 +6348,7 @@ type RsTimestampResponse struct {
 func (x *RsTimestampResponse) Reset() {
 	*x = RsTimestampResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixmessages_proto_msgTypes[87]
+		mi := &file_mixmessages_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5826,7 +6361,7 @@ func (x *RsTimestampResponse) String() string {
 func (*RsTimestampResponse) ProtoMessage() {}
 
 func (x *RsTimestampResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixmessages_proto_msgTypes[87]
+	mi := &file_mixmessages_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5839,7 +6374,7 @@ func (x *RsTimestampResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RsTimestampResponse.ProtoReflect.Descriptor instead.
 func (*RsTimestampResponse) Descriptor() ([]byte, []int) {
-	return file_mixmessages_proto_rawDescGZIP(), []int{87}
+	return file_mixmessages_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *RsTimestampResponse) GetTimestamp() int64 {
@@ -5849,6 +6384,401 @@ func (x *RsTimestampResponse) GetTimestamp() int64 {
 	return 0
 }
 
+type RsDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Token []byte `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	// Optional condition that must hold for the delete to be applied
+	Precondition *RsWritePrecondition `protobuf:"bytes,3,opt,name=Precondition,proto3" json:"Precondition,omitempty"`
+}
+
+func (x *RsDeleteRequest) Reset() {
+	*x = RsDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsDeleteRequest) ProtoMessage() {}
+
+func (x *RsDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsDeleteRequest.ProtoReflect.Descriptor instead.
+func (*RsDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RsDeleteRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsDeleteRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RsDeleteRequest) GetPrecondition() *RsWritePrecondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
+type RsStatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size         int64 `protobuf:"varint,1,opt,name=Size,proto3" json:"Size,omitempty"`
+	LastModified int64 `protobuf:"varint,2,opt,name=LastModified,proto3" json:"LastModified,omitempty"`
+	// Version of the path. A version of 0 indicates that the path does not
+	// exist.
+	Version int64 `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *RsStatResponse) Reset() {
+	*x = RsStatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsStatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsStatResponse) ProtoMessage() {}
+
+func (x *RsStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsStatResponse.ProtoReflect.Descriptor instead.
+func (*RsStatResponse) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RsStatResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *RsStatResponse) GetLastModified() int64 {
+	if x != nil {
+		return x.LastModified
+	}
+	return 0
+}
+
+func (x *RsStatResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RsRenameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldPath string `protobuf:"bytes,1,opt,name=OldPath,proto3" json:"OldPath,omitempty"`
+	NewPath string `protobuf:"bytes,2,opt,name=NewPath,proto3" json:"NewPath,omitempty"`
+	Token   []byte `protobuf:"bytes,3,opt,name=Token,proto3" json:"Token,omitempty"`
+	// Optional condition on the old path that must hold for the rename to be
+	// applied
+	Precondition *RsWritePrecondition `protobuf:"bytes,4,opt,name=Precondition,proto3" json:"Precondition,omitempty"`
+}
+
+func (x *RsRenameRequest) Reset() {
+	*x = RsRenameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsRenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsRenameRequest) ProtoMessage() {}
+
+func (x *RsRenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsRenameRequest.ProtoReflect.Descriptor instead.
+func (*RsRenameRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RsRenameRequest) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
+func (x *RsRenameRequest) GetNewPath() string {
+	if x != nil {
+		return x.NewPath
+	}
+	return ""
+}
+
+func (x *RsRenameRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RsRenameRequest) GetPrecondition() *RsWritePrecondition {
+	if x != nil {
+		return x.Precondition
+	}
+	return nil
+}
+
+// RsWriteBatchRequest is a set of writes and deletes to be applied atomically.
+// Each path may appear at most once. The tokens of the individual operations
+// are ignored in favour of the batch token.
+type RsWriteBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Writes  []*RsWriteRequest  `protobuf:"bytes,1,rep,name=Writes,proto3" json:"Writes,omitempty"`
+	Deletes []*RsDeleteRequest `protobuf:"bytes,2,rep,name=Deletes,proto3" json:"Deletes,omitempty"`
+	Token   []byte             `protobuf:"bytes,3,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *RsWriteBatchRequest) Reset() {
+	*x = RsWriteBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsWriteBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsWriteBatchRequest) ProtoMessage() {}
+
+func (x *RsWriteBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsWriteBatchRequest.ProtoReflect.Descriptor instead.
+func (*RsWriteBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RsWriteBatchRequest) GetWrites() []*RsWriteRequest {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+func (x *RsWriteBatchRequest) GetDeletes() []*RsDeleteRequest {
+	if x != nil {
+		return x.Deletes
+	}
+	return nil
+}
+
+func (x *RsWriteBatchRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RsWatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PathPrefix string `protobuf:"bytes,1,opt,name=PathPrefix,proto3" json:"PathPrefix,omitempty"`
+	Token      []byte `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *RsWatchRequest) Reset() {
+	*x = RsWatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsWatchRequest) ProtoMessage() {}
+
+func (x *RsWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsWatchRequest.ProtoReflect.Descriptor instead.
+func (*RsWatchRequest) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RsWatchRequest) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *RsWatchRequest) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RsChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string       `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	Timestamp int64        `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Kind      RsChangeKind `protobuf:"varint,3,opt,name=Kind,proto3,enum=mixmessages.RsChangeKind" json:"Kind,omitempty"`
+	// For RS_CHANGE_RENAME, the path the data was moved from
+	OldPath string `protobuf:"bytes,4,opt,name=OldPath,proto3" json:"OldPath,omitempty"`
+}
+
+func (x *RsChangeEvent) Reset() {
+	*x = RsChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixmessages_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsChangeEvent) ProtoMessage() {}
+
+func (x *RsChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mixmessages_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsChangeEvent.ProtoReflect.Descriptor instead.
+func (*RsChangeEvent) Descriptor() ([]byte, []int) {
+	return file_mixmessages_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RsChangeEvent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RsChangeEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RsChangeEvent) GetKind() RsChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return RsChangeKind_RS_CHANGE_UNKNOWN
+}
+
+func (x *RsChangeEvent) GetOldPath() string {
+	if x != nil {
+		return x.OldPath
+	}
+	return ""
+}
+
 var File_mixmessages_proto protoreflect.FileDescriptor
 
 var file_mixmessages_proto_rawDesc = []byte{
@@ -6046,614 +6976,744 @@ var file_mixmessages_proto_rawDesc = []byte{
 	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0c, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1c,
 	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x23, 0x0a, 0x0b,
+	0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3f, 0x0a, 0x0b,
 	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x44,
 	0x61, 0x74, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x44, 0x61, 0x74, 0x75,
-	0x6d, 0x22, 0x2a, 0x0a, 0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x4a, 0x0a,
-	0x18, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x34, 0x0a,
-	0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6e, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x5b, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x58, 0x0a, 0x0d,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x06, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x49,
-	0x44, 0x73, 0x22, 0x84, 0x04, 0x0a, 0x04, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
-	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a,
-	0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41,
-	0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x3c, 0x0a, 0x19, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43, 0x79,
-	0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43,
-	0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x40, 0x0a, 0x1b, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43,
-	0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x12,
-	0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53,
-	0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35,
-	0x35, 0x31, 0x39, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
-	0x4b, 0x65, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x08, 0x52, 0x0d, 0x45, 0x70, 0x68, 0x65,
-	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xd9, 0x02, 0x0a, 0x0b, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x63,
-	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
-	0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
-	0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x26, 0x0a,
-	0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x2a, 0x0a,
+	0x10, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x34, 0x0a, 0x08, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6e, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11,
+	0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x48, 0x61, 0x73, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x58, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x06,
+	0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x84, 0x04, 0x0a, 0x04, 0x53, 0x6c, 0x6f,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a,
+	0x15, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78,
+	0x74, 0x12, 0x3c, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x40, 0x0a, 0x1b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x43, 0x79, 0x70, 0x68, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x4d, 0x41,
+	0x43, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x4b, 0x4d, 0x41, 0x43, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x08,
+	0x52, 0x0d, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x22,
+	0xd9, 0x02, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x12,
+	0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
+	0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a,
+	0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x13,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44,
+	0x46, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42,
+	0x6c, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x46, 0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x22, 0x7d, 0x0a, 0x17, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
 	0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
 	0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12,
 	0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
 	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
-	0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x61, 0x72, 0x6c,
-	0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2a,
-	0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45,
-	0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
-	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x47, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x82,
-	0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x32, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x07, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
-	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f,
+	0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x32, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x52, 0x07, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x46, 0x69, 0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x8e, 0x01,
+	0x0a, 0x0c, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x34,
+	0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x96,
+	0x01, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2b,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c,
+	0x6f, 0x74, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x40, 0x0a, 0x0b, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b, 0x53, 0x6c, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x11, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
 	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0b,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
+	0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x0c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e,
 	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x41, 0x43, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x4d, 0x41, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x49, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53,
-	0x6c, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x44, 0x22, 0x58, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x70, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x49, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x0a, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64,
-	0x54, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
-	0x53, 0x22, 0x40, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e,
-	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
-	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x07, 0x4e, 0x44,
-	0x46, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4d, 0x0a, 0x03, 0x4e, 0x44, 0x46,
-	0x12, 0x10, 0x0a, 0x03, 0x4e, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4e,
-	0x64, 0x66, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c,
-	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a,
-	0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c,
-	0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x12,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x40,
-	0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x3a, 0x0a, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x18, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x1e,
+	0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x03, 0x49, 0x70, 0x73, 0x22, 0x64, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x53, 0x22, 0x40, 0x0a, 0x1a, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x49, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x25, 0x0a, 0x13,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x49, 0x44, 0x22, 0x1d, 0x0a, 0x07, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x4d, 0x0a, 0x03, 0x4e, 0x44, 0x46, 0x12, 0x10, 0x0a, 0x03, 0x4e, 0x64, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4e, 0x64, 0x66, 0x12, 0x34, 0x0a, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41,
+	0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x18, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x53, 0x41, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x5c, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x53, 0x41, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0xb0, 0x01, 0x0a, 0x1e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e,
 	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb0, 0x01, 0x0a, 0x1e, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a,
-	0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46,
+	0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x25, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x73, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67,
 	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa1, 0x02,
-	0x0a, 0x25, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1e, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a, 0x1b,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x03, 0x0a,
-	0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
-	0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07,
-	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
-	0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x22, 0x57, 0x0a, 0x0b, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x22, 0xf2, 0x02, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
-	0x44, 0x46, 0x52, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44,
-	0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a,
-	0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x45, 0x61,
-	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
-	0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a,
-	0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x12, 0x2a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6d, 0x0a, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x29, 0x0a, 0x0d, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcc, 0x03, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x46,
+	0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x04, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x52, 0x07, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x12, 0x2d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x64,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x45, 0x64, 0x32,
+	0x35, 0x35, 0x31, 0x39, 0x22, 0x57, 0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xf2, 0x02,
+	0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c,
+	0x4e, 0x44, 0x46, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x07, 0x46, 0x75, 0x6c,
+	0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x4e,
+	0x44, 0x46, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x4e, 0x44, 0x46, 0x12, 0x30, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x61, 0x72, 0x6c,
+	0x69, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x61,
+	0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x36,
+	0x0a, 0x16, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x45, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45,
+	0x72, 0x72, 0x22, 0xe6, 0x02, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41,
+	0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
+	0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12,
+	0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x72, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16,
+	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x70, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e,
+	0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
+	0x61, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a,
 	0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x16, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x41, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x70, 0x70, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x61, 0x0a, 0x1a, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a,
-	0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
-	0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72,
-	0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x22, 0xce, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x0a,
-	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
-	0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a,
-	0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a,
-	0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x12,
-	0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x49,
-	0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
-	0x61, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x49, 0x49, 0x44, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69,
-	0x67, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x7d, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64,
-	0x12, 0x34, 0x0a, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x72, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20,
-	0x0a, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50,
-	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61,
-	0x73, 0x68, 0x22, 0xb1, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31,
-	0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x55,
+	0x72, 0x65, 0x22, 0x61, 0x0a, 0x1a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a,
+	0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x1b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x73, 0x61, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x53, 0x69, 0x67, 0x22,
+	0xce, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x72, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x73, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0xcd, 0x02, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x53, 0x61, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73,
+	0x61, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x7d, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x72,
+	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x49, 0x49, 0x44,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x49, 0x49, 0x44, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x61, 0x53, 0x69, 0x67, 0x22,
+	0x1e, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22,
+	0x72, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x43,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70,
+	0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x46, 0x50, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0xb1, 0x01, 0x0a, 0x13,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x55,
 	0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36,
-	0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64,
-	0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64, 0x32,
-	0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x33, 0x0a,
-	0x19, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x15, 0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x22, 0xce, 0x02, 0x0a, 0x13, 0x55, 0x44,
-	0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x16, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x53, 0x41,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x14, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x66, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35,
+	0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x94, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x17,
+	0x55, 0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x55,
+	0x44, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x45, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x33, 0x0a, 0x19, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x12,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15,
+	0x52, 0x65, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x52, 0x65, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x50,
+	0x65, 0x6d, 0x22, 0xce, 0x02, 0x0a, 0x13, 0x55, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x16, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50,
+	0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x53, 0x41, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x14, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2c, 0x0a, 0x11, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a,
+	0x03, 0x66, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x03, 0x66, 0x72,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x55, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64,
+	0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x22, 0x68, 0x0a, 0x13, 0x46,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x55, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x46,
+	0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61,
+	0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x36, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x61, 0x63,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x46, 0x61, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a,
+	0x14, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x50, 0x0a,
+	0x12, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x75, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52,
+	0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46,
+	0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x26, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf4,
+	0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x45, 0x63, 0x63,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x43, 0x43, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0c, 0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x45, 0x41, 0x42, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x3f, 0x0a, 0x15, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65,
+	0x79, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72,
+	0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x47,
+	0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x47, 0x77, 0x49, 0x44, 0x12,
+	0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x0e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c,
+	0x74, 0x22, 0x4e, 0x0a, 0x18, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x12,
+	0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3e, 0x0a, 0x0e, 0x52, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x0e, 0x52, 0x73, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x0c, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3f, 0x0a, 0x13, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x4d, 0x0a, 0x0f, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x27, 0x0a, 0x11, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x33, 0x0a, 0x13, 0x52, 0x73, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x81, 0x01,
+	0x0a, 0x0f, 0x52, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x0c, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x03, 0x66, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x22, 0x56, 0x0a, 0x08, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x68, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61,
-	0x6c, 0x74, 0x22, 0x68, 0x0a, 0x13, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x04, 0x46,
-	0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x04, 0x46, 0x61,
-	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x36, 0x0a, 0x04,
-	0x46, 0x61, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x46, 0x61, 0x63, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x50, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x75, 0x0a, 0x12, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x33,
-	0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x46, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x22, 0x26, 0x0a,
-	0x0a, 0x53, 0x74, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf4, 0x03, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12,
-	0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12,
-	0x2f, 0x0a, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x12, 0x3c, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3e,
-	0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x65, 0x75,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x34,
-	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x3a, 0x0a, 0x0c, 0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x45, 0x43, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0c,
-	0x45, 0x63, 0x63, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x80, 0x01, 0x0a,
-	0x0a, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x53, 0x41, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
-	0x16, 0x0a, 0x14, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x45, 0x41, 0x42, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x15, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x77, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x47, 0x77, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x43, 0x4d, 0x45, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x22, 0x96, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x53,
-	0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x73, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x53, 0x61, 0x6c, 0x74, 0x22, 0x4e, 0x0a, 0x18, 0x52, 0x73, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
-	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x2a, 0x0a, 0x12, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x24, 0x0a, 0x0e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x33,
-	0x0a, 0x13, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x32, 0x96, 0x0a, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09,
+	0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x62, 0x0a, 0x0e, 0x52, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4c, 0x61, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x4c,
+	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x52, 0x73, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x6c, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x6c, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x13, 0x52, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x33, 0x0a, 0x06, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x0e, 0x52, 0x73, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x61, 0x74, 0x68,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8a, 0x01, 0x0a,
+	0x0d, 0x52, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x2d, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x4f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4f, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x2a, 0x52, 0x0a, 0x11, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17,
+	0x0a, 0x13, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4c, 0x4f, 0x54, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4c,
+	0x4f, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xcb, 0x01,
+	0x0a, 0x14, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x1a, 0x0a, 0x16, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53,
+	0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41,
+	0x44, 0x5f, 0x4d, 0x41, 0x43, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x4c, 0x4f, 0x54, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x1b,
+	0x0a, 0x17, 0x53, 0x4c, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x05, 0x2a, 0x66, 0x0a, 0x0c, 0x52,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x11, 0x52,
+	0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x52, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d,
+	0x45, 0x10, 0x03, 0x32, 0x96, 0x0a, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x3c, 0x0a, 0x09,
 	0x41, 0x73, 0x6b, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
 	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
@@ -6734,7 +7794,7 @@ var file_mixmessages_proto_rawDesc = []byte{
 	0x61, 0x72, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
 	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0xc9, 0x07, 0x0a,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0x8e, 0x0a, 0x0a,
 	0x07, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x6d,
 	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
@@ -6790,155 +7850,203 @@ var file_mixmessages_proto_rawDesc = []byte{
 	0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
 	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6c, 0x73,
-	0x43, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x43, 0x65, 0x72, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x00, 0x32, 0x78, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72, 0x12, 0x65, 0x0a, 0x0c, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x32, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x00, 0x32, 0xb4, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x6c, 0x4e, 0x64, 0x66, 0x12, 0x14,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46,
-	0x48, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x04, 0x50, 0x6f, 0x6c, 0x6c,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x18, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x1a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x54,
+	0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74,
+	0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72,
+	0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x24, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x3a, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x28, 0x01, 0x32, 0x78, 0x0a,
+	0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x72,
+	0x12, 0x65, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x32, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x00, 0x32, 0xb4, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x6c,
+	0x4e, 0x64, 0x66, 0x12, 0x14, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x4e, 0x44, 0x46, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x44, 0x46, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x04, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x1a, 0x27, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xbc,
+	0x04, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6f, 0x74, 0x12, 0x59, 0x0a, 0x1a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a,
+	0x18, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41,
+	0x63, 0x6b, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68,
 	0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
 	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d,
-	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0x27,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xbc, 0x04, 0x0a, 0x0f, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x74, 0x12, 0x59, 0x0a,
-	0x1a, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x69,
-	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x18, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0d,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22,
-	0x00, 0x12, 0x47, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12,
-	0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x55, 0x6e, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x44, 0x12, 0x27,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0x9d, 0x04, 0x0a, 0x03, 0x55, 0x44, 0x42,
-	0x12, 0x41, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55,
-	0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
-	0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
-	0x61, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x13, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x2e,
-	0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xed, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b,
-	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x00, 0x12, 0x43, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x41,
-	0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc3, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x54, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x24, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52,
-	0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
-	0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
-	0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x41, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13,
+	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x49, 0x44, 0x12, 0x27, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x32, 0x9d, 0x04,
+	0x0a, 0x03, 0x55, 0x44, 0x42, 0x12, 0x41, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x55, 0x44, 0x42, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x46, 0x61, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x12, 0x1f,
+	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00,
+	0x12, 0x5c, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x32, 0xed, 0x01,
+	0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x43,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x45, 0x41, 0x42, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xf5, 0x06,
+	0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x54, 0x0a, 0x05,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07,
+	0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x28, 0x01, 0x12, 0x44, 0x0a,
+	0x0a, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x6d, 0x69,
+	0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d,
+	0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x3f,
+	0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73,
 	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x12, 0x1a, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x52, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26,
-	0x5a, 0x24, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x69,
-	0x78, 0x78, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x73, 0x2f, 0x6d, 0x69, 0x78, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x69, 0x78, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x73, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x12, 0x3d, 0x0a, 0x0a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x73, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x6b, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6c, 0x69, 0x78, 0x78, 0x69, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x73, 0x2f, 0x6d, 0x69, 0x78, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6953,280 +8061,329 @@ func file_mixmessages_proto_rawDescGZIP() []byte {
 	return file_mixmessages_proto_rawDescData
 }
 
-var file_mixmessages_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
+var file_mixmessages_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_mixmessages_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
 var file_mixmessages_proto_goTypes = []interface{}{
-	(*ClientKeyRequest)(nil),                      // 0: mixmessages.ClientKeyRequest
-	(*SignedClientBatchKeyRequest)(nil),           // 1: mixmessages.SignedClientBatchKeyRequest
-	(*SignedClientKeyRequest)(nil),                // 2: mixmessages.SignedClientKeyRequest
-	(*ClientKeyResponse)(nil),                     // 3: mixmessages.ClientKeyResponse
-	(*SignedBatchKeyResponse)(nil),                // 4: mixmessages.SignedBatchKeyResponse
-	(*SignedKeyResponse)(nil),                     // 5: mixmessages.SignedKeyResponse
-	(*PostPrecompResult)(nil),                     // 6: mixmessages.PostPrecompResult
-	(*RoundBufferInfo)(nil),                       // 7: mixmessages.RoundBufferInfo
-	(*RoundPublicKey)(nil),                        // 8: mixmessages.RoundPublicKey
-	(*RoundMetrics)(nil),                          // 9: mixmessages.RoundMetrics
-	(*Batch)(nil),                                 // 10: mixmessages.Batch
-	(*CompletedBatch)(nil),                        // 11: mixmessages.CompletedBatch
-	(*BatchInfo)(nil),                             // 12: mixmessages.BatchInfo
-	(*RoundTripPing)(nil),                         // 13: mixmessages.RoundTripPing
-	(*ServerPoll)(nil),                            // 14: mixmessages.ServerPoll
-	(*ServerPollResponse)(nil),                    // 15: mixmessages.ServerPollResponse
-	(*BatchReady)(nil),                            // 16: mixmessages.BatchReady
-	(*SharePiece)(nil),                            // 17: mixmessages.SharePiece
-	(*RequestGatewayCert)(nil),                    // 18: mixmessages.RequestGatewayCert
-	(*GatewayCertificate)(nil),                    // 19: mixmessages.GatewayCertificate
-	(*StreamChunk)(nil),                           // 20: mixmessages.StreamChunk
-	(*HistoricalRounds)(nil),                      // 21: mixmessages.HistoricalRounds
-	(*HistoricalRoundsResponse)(nil),              // 22: mixmessages.HistoricalRoundsResponse
-	(*GetMessagesBatch)(nil),                      // 23: mixmessages.GetMessagesBatch
-	(*GetMessagesResponseBatch)(nil),              // 24: mixmessages.GetMessagesResponseBatch
-	(*GetMessages)(nil),                           // 25: mixmessages.GetMessages
-	(*GetMessagesResponse)(nil),                   // 26: mixmessages.GetMessagesResponse
-	(*RoundMessages)(nil),                         // 27: mixmessages.RoundMessages
-	(*IDList)(nil),                                // 28: mixmessages.IDList
-	(*Slot)(nil),                                  // 29: mixmessages.Slot
-	(*GatewayPoll)(nil),                           // 30: mixmessages.GatewayPoll
-	(*GatewayPollResponse)(nil),                   // 31: mixmessages.GatewayPollResponse
-	(*ClientBlooms)(nil),                          // 32: mixmessages.ClientBlooms
-	(*ClientBloom)(nil),                           // 33: mixmessages.ClientBloom
-	(*GatewaySlots)(nil),                          // 34: mixmessages.GatewaySlots
-	(*GatewaySlot)(nil),                           // 35: mixmessages.GatewaySlot
-	(*GatewaySlotResponse)(nil),                   // 36: mixmessages.GatewaySlotResponse
-	(*BatchSenders)(nil),                          // 37: mixmessages.BatchSenders
-	(*Recipients)(nil),                            // 38: mixmessages.Recipients
-	(*RegisteredNodeConfirmation)(nil),            // 39: mixmessages.RegisteredNodeConfirmation
-	(*RegisteredNodeCheck)(nil),                   // 40: mixmessages.RegisteredNodeCheck
-	(*NDFHash)(nil),                               // 41: mixmessages.NDFHash
-	(*NDF)(nil),                                   // 42: mixmessages.NDF
-	(*NodeRegistration)(nil),                      // 43: mixmessages.NodeRegistration
-	(*ClientRegistration)(nil),                    // 44: mixmessages.ClientRegistration
-	(*ClientRegistrationConfirmation)(nil),        // 45: mixmessages.ClientRegistrationConfirmation
-	(*SignedRegistrationConfirmation)(nil),        // 46: mixmessages.SignedRegistrationConfirmation
-	(*SignedClientRegistrationConfirmations)(nil), // 47: mixmessages.SignedClientRegistrationConfirmations
-	(*ClientVersion)(nil),                         // 48: mixmessages.ClientVersion
-	(*PermissioningPoll)(nil),                     // 49: mixmessages.PermissioningPoll
-	(*ClientError)(nil),                           // 50: mixmessages.ClientError
-	(*PermissionPollResponse)(nil),                // 51: mixmessages.PermissionPollResponse
-	(*RegisterTokenRequest)(nil),                  // 52: mixmessages.RegisterTokenRequest
-	(*UnregisterTokenRequest)(nil),                // 53: mixmessages.UnregisterTokenRequest
-	(*UnregisterTrackedIdRequest)(nil),            // 54: mixmessages.UnregisterTrackedIdRequest
-	(*RegisterTrackedIdRequest)(nil),              // 55: mixmessages.RegisterTrackedIdRequest
-	(*TrackedIntermediaryIdRequest)(nil),          // 56: mixmessages.TrackedIntermediaryIdRequest
-	(*NotificationRegisterRequest)(nil),           // 57: mixmessages.NotificationRegisterRequest
-	(*NotificationUnregisterRequest)(nil),         // 58: mixmessages.NotificationUnregisterRequest
-	(*UserIdList)(nil),                            // 59: mixmessages.UserIdList
-	(*NotificationBatch)(nil),                     // 60: mixmessages.NotificationBatch
-	(*NotificationData)(nil),                      // 61: mixmessages.NotificationData
-	(*ChannelLeaseRequest)(nil),                   // 62: mixmessages.ChannelLeaseRequest
-	(*ChannelLeaseResponse)(nil),                  // 63: mixmessages.ChannelLeaseResponse
-	(*UsernameValidationRequest)(nil),             // 64: mixmessages.UsernameValidationRequest
-	(*UsernameValidation)(nil),                    // 65: mixmessages.UsernameValidation
-	(*UDBUserRegistration)(nil),                   // 66: mixmessages.UDBUserRegistration
-	(*Identity)(nil),                              // 67: mixmessages.Identity
-	(*FactRegisterRequest)(nil),                   // 68: mixmessages.FactRegisterRequest
-	(*Fact)(nil),                                  // 69: mixmessages.Fact
-	(*FactRegisterResponse)(nil),                  // 70: mixmessages.FactRegisterResponse
-	(*FactConfirmRequest)(nil),                    // 71: mixmessages.FactConfirmRequest
-	(*FactRemovalRequest)(nil),                    // 72: mixmessages.FactRemovalRequest
-	(*StrAddress)(nil),                            // 73: mixmessages.StrAddress
-	(*RoundInfo)(nil),                             // 74: mixmessages.RoundInfo
-	(*RoundError)(nil),                            // 75: mixmessages.RoundError
-	(*EABCredentialRequest)(nil),                  // 76: mixmessages.EABCredentialRequest
-	(*EABCredentialResponse)(nil),                 // 77: mixmessages.EABCredentialResponse
-	(*AuthorizerCertRequest)(nil),                 // 78: mixmessages.AuthorizerCertRequest
-	(*AuthorizerAuth)(nil),                        // 79: mixmessages.AuthorizerAuth
-	(*RsAuthenticationRequest)(nil),               // 80: mixmessages.RsAuthenticationRequest
-	(*RsAuthenticationResponse)(nil),              // 81: mixmessages.RsAuthenticationResponse
-	(*RsReadRequest)(nil),                         // 82: mixmessages.RsReadRequest
-	(*RsLastWriteRequest)(nil),                    // 83: mixmessages.RsLastWriteRequest
-	(*RsReadResponse)(nil),                        // 84: mixmessages.RsReadResponse
-	(*RsWriteRequest)(nil),                        // 85: mixmessages.RsWriteRequest
-	(*RsReadDirResponse)(nil),                     // 86: mixmessages.RsReadDirResponse
-	(*RsTimestampResponse)(nil),                   // 87: mixmessages.RsTimestampResponse
-	(*messages.RSASignature)(nil),                 // 88: messages.RSASignature
-	(*anypb.Any)(nil),                             // 89: google.protobuf.Any
-	(*messages.ECCSignature)(nil),                 // 90: messages.ECCSignature
-	(*messages.AuthenticatedMessage)(nil),         // 91: messages.AuthenticatedMessage
-	(*messages.Ping)(nil),                         // 92: messages.Ping
-	(*messages.Ack)(nil),                          // 93: messages.Ack
+	(GatewaySlotStatus)(0),                        // 0: mixmessages.GatewaySlotStatus
+	(GatewaySlotRejection)(0),                     // 1: mixmessages.GatewaySlotRejection
+	(RsChangeKind)(0),                             // 2: mixmessages.RsChangeKind
+	(*ClientKeyRequest)(nil),                      // 3: mixmessages.ClientKeyRequest
+	(*SignedClientBatchKeyRequest)(nil),           // 4: mixmessages.SignedClientBatchKeyRequest
+	(*SignedClientKeyRequest)(nil),                // 5: mixmessages.SignedClientKeyRequest
+	(*ClientKeyResponse)(nil),                     // 6: mixmessages.ClientKeyResponse
+	(*SignedBatchKeyResponse)(nil),                // 7: mixmessages.SignedBatchKeyResponse
+	(*SignedKeyResponse)(nil),                     // 8: mixmessages.SignedKeyResponse
+	(*PostPrecompResult)(nil),                     // 9: mixmessages.PostPrecompResult
+	(*RoundBufferInfo)(nil),                       // 10: mixmessages.RoundBufferInfo
+	(*RoundPublicKey)(nil),                        // 11: mixmessages.RoundPublicKey
+	(*RoundMetrics)(nil),                          // 12: mixmessages.RoundMetrics
+	(*Batch)(nil),                                 // 13: mixmessages.Batch
+	(*CompletedBatch)(nil),                        // 14: mixmessages.CompletedBatch
+	(*BatchInfo)(nil),                             // 15: mixmessages.BatchInfo
+	(*RoundTripPing)(nil),                         // 16: mixmessages.RoundTripPing
+	(*ServerPoll)(nil),                            // 17: mixmessages.ServerPoll
+	(*ServerPollResponse)(nil),                    // 18: mixmessages.ServerPollResponse
+	(*BatchReady)(nil),                            // 19: mixmessages.BatchReady
+	(*SharePiece)(nil),                            // 20: mixmessages.SharePiece
+	(*RequestGatewayCert)(nil),                    // 21: mixmessages.RequestGatewayCert
+	(*GatewayCertificate)(nil),                    // 22: mixmessages.GatewayCertificate
+	(*StreamChunk)(nil),                           // 23: mixmessages.StreamChunk
+	(*HistoricalRounds)(nil),                      // 24: mixmessages.HistoricalRounds
+	(*HistoricalRoundsResponse)(nil),              // 25: mixmessages.HistoricalRoundsResponse
+	(*GetMessagesBatch)(nil),                      // 26: mixmessages.GetMessagesBatch
+	(*GetMessagesResponseBatch)(nil),              // 27: mixmessages.GetMessagesResponseBatch
+	(*GetMessages)(nil),                           // 28: mixmessages.GetMessages
+	(*GetMessagesResponse)(nil),                   // 29: mixmessages.GetMessagesResponse
+	(*RoundMessages)(nil),                         // 30: mixmessages.RoundMessages
+	(*IDList)(nil),                                // 31: mixmessages.IDList
+	(*Slot)(nil),                                  // 32: mixmessages.Slot
+	(*GatewayPoll)(nil),                           // 33: mixmessages.GatewayPoll
+	(*GatewayPollResponse)(nil),                   // 34: mixmessages.GatewayPollResponse
+	(*RoundSubscription)(nil),                     // 35: mixmessages.RoundSubscription
+	(*RoundSubscriptionUpdate)(nil),               // 36: mixmessages.RoundSubscriptionUpdate
+	(*ClientBlooms)(nil),                          // 37: mixmessages.ClientBlooms
+	(*ClientBloom)(nil),                           // 38: mixmessages.ClientBloom
+	(*GatewaySlots)(nil),                          // 39: mixmessages.GatewaySlots
+	(*GatewaySlot)(nil),                           // 40: mixmessages.GatewaySlot
+	(*GatewaySlotResponse)(nil),                   // 41: mixmessages.GatewaySlotResponse
+	(*GatewaySlotResult)(nil),                     // 42: mixmessages.GatewaySlotResult
+	(*BatchSenders)(nil),                          // 43: mixmessages.BatchSenders
+	(*Recipients)(nil),                            // 44: mixmessages.Recipients
+	(*RegisteredNodeConfirmation)(nil),            // 45: mixmessages.RegisteredNodeConfirmation
+	(*RegisteredNodeCheck)(nil),                   // 46: mixmessages.RegisteredNodeCheck
+	(*NDFHash)(nil),                               // 47: mixmessages.NDFHash
+	(*NDF)(nil),                                   // 48: mixmessages.NDF
+	(*NodeRegistration)(nil),                      // 49: mixmessages.NodeRegistration
+	(*ClientRegistration)(nil),                    // 50: mixmessages.ClientRegistration
+	(*ClientRegistrationConfirmation)(nil),        // 51: mixmessages.ClientRegistrationConfirmation
+	(*SignedRegistrationConfirmation)(nil),        // 52: mixmessages.SignedRegistrationConfirmation
+	(*SignedClientRegistrationConfirmations)(nil), // 53: mixmessages.SignedClientRegistrationConfirmations
+	(*ClientVersion)(nil),                         // 54: mixmessages.ClientVersion
+	(*PermissioningPoll)(nil),                     // 55: mixmessages.PermissioningPoll
+	(*ClientError)(nil),                           // 56: mixmessages.ClientError
+	(*PermissionPollResponse)(nil),                // 57: mixmessages.PermissionPollResponse
+	(*RegisterTokenRequest)(nil),                  // 58: mixmessages.RegisterTokenRequest
+	(*UnregisterTokenRequest)(nil),                // 59: mixmessages.UnregisterTokenRequest
+	(*UnregisterTrackedIdRequest)(nil),            // 60: mixmessages.UnregisterTrackedIdRequest
+	(*RegisterTrackedIdRequest)(nil),              // 61: mixmessages.RegisterTrackedIdRequest
+	(*TrackedIntermediaryIdRequest)(nil),          // 62: mixmessages.TrackedIntermediaryIdRequest
+	(*NotificationRegisterRequest)(nil),           // 63: mixmessages.NotificationRegisterRequest
+	(*NotificationUnregisterRequest)(nil),         // 64: mixmessages.NotificationUnregisterRequest
+	(*UserIdList)(nil),                            // 65: mixmessages.UserIdList
+	(*NotificationBatch)(nil),                     // 66: mixmessages.NotificationBatch
+	(*NotificationData)(nil),                      // 67: mixmessages.NotificationData
+	(*ChannelLeaseRequest)(nil),                   // 68: mixmessages.ChannelLeaseRequest
+	(*ChannelLeaseResponse)(nil),                  // 69: mixmessages.ChannelLeaseResponse
+	(*UsernameValidationRequest)(nil),             // 70: mixmessages.UsernameValidationRequest
+	(*UsernameValidation)(nil),                    // 71: mixmessages.UsernameValidation
+	(*UDBUserRegistration)(nil),                   // 72: mixmessages.UDBUserRegistration
+	(*Identity)(nil),                              // 73: mixmessages.Identity
+	(*FactRegisterRequest)(nil),                   // 74: mixmessages.FactRegisterRequest
+	(*Fact)(nil),                                  // 75: mixmessages.Fact
+	(*FactRegisterResponse)(nil),                  // 76: mixmessages.FactRegisterResponse
+	(*FactConfirmRequest)(nil),                    // 77: mixmessages.FactConfirmRequest
+	(*FactRemovalRequest)(nil),                    // 78: mixmessages.FactRemovalRequest
+	(*StrAddress)(nil),                            // 79: mixmessages.StrAddress
+	(*RoundInfo)(nil),                             // 80: mixmessages.RoundInfo
+	(*RoundError)(nil),                            // 81: mixmessages.RoundError
+	(*EABCredentialRequest)(nil),                  // 82: mixmessages.EABCredentialRequest
+	(*EABCredentialResponse)(nil),                 // 83: mixmessages.EABCredentialResponse
+	(*AuthorizerCertRequest)(nil),                 // 84: mixmessages.AuthorizerCertRequest
+	(*AuthorizerAuth)(nil),                        // 85: mixmessages.AuthorizerAuth
+	(*RsAuthenticationRequest)(nil),               // 86: mixmessages.RsAuthenticationRequest
+	(*RsAuthenticationResponse)(nil),              // 87: mixmessages.RsAuthenticationResponse
+	(*RsReadRequest)(nil),                         // 88: mixmessages.RsReadRequest
+	(*RsLastWriteRequest)(nil),                    // 89: mixmessages.RsLastWriteRequest
+	(*RsReadResponse)(nil),                        // 90: mixmessages.RsReadResponse
+	(*RsWriteRequest)(nil),                        // 91: mixmessages.RsWriteRequest
+	(*RsWritePrecondition)(nil),                   // 92: mixmessages.RsWritePrecondition
+	(*RsWriteConflict)(nil),                       // 93: mixmessages.RsWriteConflict
+	(*RsReadDirResponse)(nil),                     // 94: mixmessages.RsReadDirResponse
+	(*RsTimestampResponse)(nil),                   // 95: mixmessages.RsTimestampResponse
+	(*RsDeleteRequest)(nil),                       // 96: mixmessages.RsDeleteRequest
+	(*RsStatResponse)(nil),                        // 97: mixmessages.RsStatResponse
+	(*RsRenameRequest)(nil),                       // 98: mixmessages.RsRenameRequest
+	(*RsWriteBatchRequest)(nil),                   // 99: mixmessages.RsWriteBatchRequest
+	(*RsWatchRequest)(nil),                        // 100: mixmessages.RsWatchRequest
+	(*RsChangeEvent)(nil),                         // 101: mixmessages.RsChangeEvent
+	(*messages.RSASignature)(nil),                 // 102: messages.RSASignature
+	(*anypb.Any)(nil),                             // 103: google.protobuf.Any
+	(*messages.ECCSignature)(nil),                 // 104: messages.ECCSignature
+	(*messages.AuthenticatedMessage)(nil),         // 105: messages.AuthenticatedMessage
+	(*messages.Ping)(nil),                         // 106: messages.Ping
+	(*messages.Ack)(nil),                          // 107: messages.Ack
 }
 var file_mixmessages_proto_depIdxs = []int32{
-	46,  // 0: mixmessages.ClientKeyRequest.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	88,  // 1: mixmessages.SignedClientBatchKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
-	88,  // 2: mixmessages.SignedClientKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
-	5,   // 3: mixmessages.SignedBatchKeyResponse.SignedKeys:type_name -> mixmessages.SignedKeyResponse
-	88,  // 4: mixmessages.SignedKeyResponse.KeyResponseSignedByGateway:type_name -> messages.RSASignature
-	74,  // 5: mixmessages.RoundPublicKey.Round:type_name -> mixmessages.RoundInfo
-	74,  // 6: mixmessages.Batch.Round:type_name -> mixmessages.RoundInfo
-	29,  // 7: mixmessages.Batch.slots:type_name -> mixmessages.Slot
-	29,  // 8: mixmessages.CompletedBatch.slots:type_name -> mixmessages.Slot
-	74,  // 9: mixmessages.BatchInfo.Round:type_name -> mixmessages.RoundInfo
-	89,  // 10: mixmessages.RoundTripPing.Payload:type_name -> google.protobuf.Any
-	74,  // 11: mixmessages.RoundTripPing.Round:type_name -> mixmessages.RoundInfo
-	41,  // 12: mixmessages.ServerPoll.Full:type_name -> mixmessages.NDFHash
-	41,  // 13: mixmessages.ServerPoll.Partial:type_name -> mixmessages.NDFHash
-	42,  // 14: mixmessages.ServerPollResponse.FullNDF:type_name -> mixmessages.NDF
-	42,  // 15: mixmessages.ServerPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 16: mixmessages.ServerPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	74,  // 17: mixmessages.ServerPollResponse.BatchRequest:type_name -> mixmessages.RoundInfo
-	16,  // 18: mixmessages.ServerPollResponse.Batch:type_name -> mixmessages.BatchReady
-	88,  // 19: mixmessages.SharePiece.Signature:type_name -> messages.RSASignature
-	74,  // 20: mixmessages.HistoricalRoundsResponse.Rounds:type_name -> mixmessages.RoundInfo
-	25,  // 21: mixmessages.GetMessagesBatch.Requests:type_name -> mixmessages.GetMessages
-	26,  // 22: mixmessages.GetMessagesResponseBatch.Results:type_name -> mixmessages.GetMessagesResponse
-	29,  // 23: mixmessages.GetMessagesResponse.Messages:type_name -> mixmessages.Slot
-	29,  // 24: mixmessages.RoundMessages.Messages:type_name -> mixmessages.Slot
-	41,  // 25: mixmessages.GatewayPoll.Partial:type_name -> mixmessages.NDFHash
-	42,  // 26: mixmessages.GatewayPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 27: mixmessages.GatewayPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	32,  // 28: mixmessages.GatewayPollResponse.Filters:type_name -> mixmessages.ClientBlooms
-	33,  // 29: mixmessages.ClientBlooms.Filters:type_name -> mixmessages.ClientBloom
-	35,  // 30: mixmessages.GatewaySlots.Messages:type_name -> mixmessages.GatewaySlot
-	29,  // 31: mixmessages.GatewaySlot.Message:type_name -> mixmessages.Slot
-	88,  // 32: mixmessages.NDF.Signature:type_name -> messages.RSASignature
-	88,  // 33: mixmessages.SignedRegistrationConfirmation.RegistrarSignature:type_name -> messages.RSASignature
-	46,  // 34: mixmessages.SignedClientRegistrationConfirmations.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	46,  // 35: mixmessages.SignedClientRegistrationConfirmations.ClientReceptionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
-	41,  // 36: mixmessages.PermissioningPoll.Full:type_name -> mixmessages.NDFHash
-	41,  // 37: mixmessages.PermissioningPoll.Partial:type_name -> mixmessages.NDFHash
-	75,  // 38: mixmessages.PermissioningPoll.Error:type_name -> mixmessages.RoundError
-	50,  // 39: mixmessages.PermissioningPoll.ClientErrors:type_name -> mixmessages.ClientError
-	42,  // 40: mixmessages.PermissionPollResponse.FullNDF:type_name -> mixmessages.NDF
-	42,  // 41: mixmessages.PermissionPollResponse.PartialNDF:type_name -> mixmessages.NDF
-	74,  // 42: mixmessages.PermissionPollResponse.Updates:type_name -> mixmessages.RoundInfo
-	56,  // 43: mixmessages.UnregisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
-	56,  // 44: mixmessages.RegisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
-	61,  // 45: mixmessages.NotificationBatch.notifications:type_name -> mixmessages.NotificationData
-	67,  // 46: mixmessages.UDBUserRegistration.IdentityRegistration:type_name -> mixmessages.Identity
-	68,  // 47: mixmessages.UDBUserRegistration.frs:type_name -> mixmessages.FactRegisterRequest
-	69,  // 48: mixmessages.FactRegisterRequest.Fact:type_name -> mixmessages.Fact
-	69,  // 49: mixmessages.FactRemovalRequest.RemovalData:type_name -> mixmessages.Fact
-	75,  // 50: mixmessages.RoundInfo.Errors:type_name -> mixmessages.RoundError
-	50,  // 51: mixmessages.RoundInfo.ClientErrors:type_name -> mixmessages.ClientError
-	88,  // 52: mixmessages.RoundInfo.Signature:type_name -> messages.RSASignature
-	90,  // 53: mixmessages.RoundInfo.EccSignature:type_name -> messages.ECCSignature
-	88,  // 54: mixmessages.RoundError.Signature:type_name -> messages.RSASignature
-	91,  // 55: mixmessages.Node.AskOnline:input_type -> messages.AuthenticatedMessage
-	91,  // 56: mixmessages.Node.CreateNewRound:input_type -> messages.AuthenticatedMessage
-	29,  // 57: mixmessages.Node.UploadUnmixedBatch:input_type -> mixmessages.Slot
-	29,  // 58: mixmessages.Node.FinishRealtime:input_type -> mixmessages.Slot
-	29,  // 59: mixmessages.Node.PrecompTestBatch:input_type -> mixmessages.Slot
-	91,  // 60: mixmessages.Node.PostPhase:input_type -> messages.AuthenticatedMessage
-	29,  // 61: mixmessages.Node.StreamPostPhase:input_type -> mixmessages.Slot
-	91,  // 62: mixmessages.Node.GetRoundBufferInfo:input_type -> messages.AuthenticatedMessage
-	91,  // 63: mixmessages.Node.RequestClientKey:input_type -> messages.AuthenticatedMessage
-	91,  // 64: mixmessages.Node.PostPrecompResult:input_type -> messages.AuthenticatedMessage
-	91,  // 65: mixmessages.Node.GetMeasure:input_type -> messages.AuthenticatedMessage
-	91,  // 66: mixmessages.Node.Poll:input_type -> messages.AuthenticatedMessage
-	91,  // 67: mixmessages.Node.DownloadMixedBatch:input_type -> messages.AuthenticatedMessage
-	91,  // 68: mixmessages.Node.SendRoundTripPing:input_type -> messages.AuthenticatedMessage
-	91,  // 69: mixmessages.Node.RoundError:input_type -> messages.AuthenticatedMessage
-	92,  // 70: mixmessages.Node.GetPermissioningAddress:input_type -> messages.Ping
-	91,  // 71: mixmessages.Node.StartSharePhase:input_type -> messages.AuthenticatedMessage
-	91,  // 72: mixmessages.Node.SharePhaseRound:input_type -> messages.AuthenticatedMessage
-	91,  // 73: mixmessages.Node.ShareFinalKey:input_type -> messages.AuthenticatedMessage
-	2,   // 74: mixmessages.Gateway.RequestClientKey:input_type -> mixmessages.SignedClientKeyRequest
-	1,   // 75: mixmessages.Gateway.BatchNodeRegistration:input_type -> mixmessages.SignedClientBatchKeyRequest
-	35,  // 76: mixmessages.Gateway.PutMessage:input_type -> mixmessages.GatewaySlot
-	34,  // 77: mixmessages.Gateway.PutManyMessages:input_type -> mixmessages.GatewaySlots
-	91,  // 78: mixmessages.Gateway.PutMessageProxy:input_type -> messages.AuthenticatedMessage
-	91,  // 79: mixmessages.Gateway.PutManyMessagesProxy:input_type -> messages.AuthenticatedMessage
-	30,  // 80: mixmessages.Gateway.Poll:input_type -> mixmessages.GatewayPoll
-	21,  // 81: mixmessages.Gateway.RequestHistoricalRounds:input_type -> mixmessages.HistoricalRounds
-	25,  // 82: mixmessages.Gateway.RequestMessages:input_type -> mixmessages.GetMessages
-	23,  // 83: mixmessages.Gateway.RequestBatchMessages:input_type -> mixmessages.GetMessagesBatch
-	18,  // 84: mixmessages.Gateway.RequestTlsCert:input_type -> mixmessages.RequestGatewayCert
-	44,  // 85: mixmessages.ClientRegistrar.RegisterUser:input_type -> mixmessages.ClientRegistration
-	43,  // 86: mixmessages.Registration.RegisterNode:input_type -> mixmessages.NodeRegistration
-	41,  // 87: mixmessages.Registration.PollNdf:input_type -> mixmessages.NDFHash
-	91,  // 88: mixmessages.Registration.Poll:input_type -> messages.AuthenticatedMessage
-	40,  // 89: mixmessages.Registration.CheckRegistration:input_type -> mixmessages.RegisteredNodeCheck
-	58,  // 90: mixmessages.NotificationBot.UnregisterForNotifications:input_type -> mixmessages.NotificationUnregisterRequest
-	57,  // 91: mixmessages.NotificationBot.RegisterForNotifications:input_type -> mixmessages.NotificationRegisterRequest
-	91,  // 92: mixmessages.NotificationBot.ReceiveNotificationBatch:input_type -> messages.AuthenticatedMessage
-	52,  // 93: mixmessages.NotificationBot.RegisterToken:input_type -> mixmessages.RegisterTokenRequest
-	53,  // 94: mixmessages.NotificationBot.UnregisterToken:input_type -> mixmessages.UnregisterTokenRequest
-	55,  // 95: mixmessages.NotificationBot.RegisterTrackedID:input_type -> mixmessages.RegisterTrackedIdRequest
-	54,  // 96: mixmessages.NotificationBot.UnregisterTrackedID:input_type -> mixmessages.UnregisterTrackedIdRequest
-	66,  // 97: mixmessages.UDB.RegisterUser:input_type -> mixmessages.UDBUserRegistration
-	72,  // 98: mixmessages.UDB.RemoveUser:input_type -> mixmessages.FactRemovalRequest
-	68,  // 99: mixmessages.UDB.RegisterFact:input_type -> mixmessages.FactRegisterRequest
-	71,  // 100: mixmessages.UDB.ConfirmFact:input_type -> mixmessages.FactConfirmRequest
-	72,  // 101: mixmessages.UDB.RemoveFact:input_type -> mixmessages.FactRemovalRequest
-	62,  // 102: mixmessages.UDB.RequestChannelLease:input_type -> mixmessages.ChannelLeaseRequest
-	64,  // 103: mixmessages.UDB.ValidateUsername:input_type -> mixmessages.UsernameValidationRequest
-	79,  // 104: mixmessages.Authorizer.Authorize:input_type -> mixmessages.AuthorizerAuth
-	78,  // 105: mixmessages.Authorizer.RequestCert:input_type -> mixmessages.AuthorizerCertRequest
-	76,  // 106: mixmessages.Authorizer.RequestEABCredentials:input_type -> mixmessages.EABCredentialRequest
-	80,  // 107: mixmessages.RemoteSync.Login:input_type -> mixmessages.RsAuthenticationRequest
-	82,  // 108: mixmessages.RemoteSync.Read:input_type -> mixmessages.RsReadRequest
-	85,  // 109: mixmessages.RemoteSync.Write:input_type -> mixmessages.RsWriteRequest
-	82,  // 110: mixmessages.RemoteSync.GetLastModified:input_type -> mixmessages.RsReadRequest
-	83,  // 111: mixmessages.RemoteSync.GetLastWrite:input_type -> mixmessages.RsLastWriteRequest
-	82,  // 112: mixmessages.RemoteSync.ReadDir:input_type -> mixmessages.RsReadRequest
-	93,  // 113: mixmessages.Node.AskOnline:output_type -> messages.Ack
-	93,  // 114: mixmessages.Node.CreateNewRound:output_type -> messages.Ack
-	93,  // 115: mixmessages.Node.UploadUnmixedBatch:output_type -> messages.Ack
-	93,  // 116: mixmessages.Node.FinishRealtime:output_type -> messages.Ack
-	93,  // 117: mixmessages.Node.PrecompTestBatch:output_type -> messages.Ack
-	93,  // 118: mixmessages.Node.PostPhase:output_type -> messages.Ack
-	93,  // 119: mixmessages.Node.StreamPostPhase:output_type -> messages.Ack
-	7,   // 120: mixmessages.Node.GetRoundBufferInfo:output_type -> mixmessages.RoundBufferInfo
-	5,   // 121: mixmessages.Node.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
-	93,  // 122: mixmessages.Node.PostPrecompResult:output_type -> messages.Ack
-	9,   // 123: mixmessages.Node.GetMeasure:output_type -> mixmessages.RoundMetrics
-	15,  // 124: mixmessages.Node.Poll:output_type -> mixmessages.ServerPollResponse
-	29,  // 125: mixmessages.Node.DownloadMixedBatch:output_type -> mixmessages.Slot
-	93,  // 126: mixmessages.Node.SendRoundTripPing:output_type -> messages.Ack
-	93,  // 127: mixmessages.Node.RoundError:output_type -> messages.Ack
-	73,  // 128: mixmessages.Node.GetPermissioningAddress:output_type -> mixmessages.StrAddress
-	93,  // 129: mixmessages.Node.StartSharePhase:output_type -> messages.Ack
-	93,  // 130: mixmessages.Node.SharePhaseRound:output_type -> messages.Ack
-	93,  // 131: mixmessages.Node.ShareFinalKey:output_type -> messages.Ack
-	5,   // 132: mixmessages.Gateway.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
-	4,   // 133: mixmessages.Gateway.BatchNodeRegistration:output_type -> mixmessages.SignedBatchKeyResponse
-	36,  // 134: mixmessages.Gateway.PutMessage:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 135: mixmessages.Gateway.PutManyMessages:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 136: mixmessages.Gateway.PutMessageProxy:output_type -> mixmessages.GatewaySlotResponse
-	36,  // 137: mixmessages.Gateway.PutManyMessagesProxy:output_type -> mixmessages.GatewaySlotResponse
-	20,  // 138: mixmessages.Gateway.Poll:output_type -> mixmessages.StreamChunk
-	22,  // 139: mixmessages.Gateway.RequestHistoricalRounds:output_type -> mixmessages.HistoricalRoundsResponse
-	26,  // 140: mixmessages.Gateway.RequestMessages:output_type -> mixmessages.GetMessagesResponse
-	24,  // 141: mixmessages.Gateway.RequestBatchMessages:output_type -> mixmessages.GetMessagesResponseBatch
-	19,  // 142: mixmessages.Gateway.RequestTlsCert:output_type -> mixmessages.GatewayCertificate
-	47,  // 143: mixmessages.ClientRegistrar.RegisterUser:output_type -> mixmessages.SignedClientRegistrationConfirmations
-	93,  // 144: mixmessages.Registration.RegisterNode:output_type -> messages.Ack
-	42,  // 145: mixmessages.Registration.PollNdf:output_type -> mixmessages.NDF
-	51,  // 146: mixmessages.Registration.Poll:output_type -> mixmessages.PermissionPollResponse
-	39,  // 147: mixmessages.Registration.CheckRegistration:output_type -> mixmessages.RegisteredNodeConfirmation
-	93,  // 148: mixmessages.NotificationBot.UnregisterForNotifications:output_type -> messages.Ack
-	93,  // 149: mixmessages.NotificationBot.RegisterForNotifications:output_type -> messages.Ack
-	93,  // 150: mixmessages.NotificationBot.ReceiveNotificationBatch:output_type -> messages.Ack
-	93,  // 151: mixmessages.NotificationBot.RegisterToken:output_type -> messages.Ack
-	93,  // 152: mixmessages.NotificationBot.UnregisterToken:output_type -> messages.Ack
-	93,  // 153: mixmessages.NotificationBot.RegisterTrackedID:output_type -> messages.Ack
-	93,  // 154: mixmessages.NotificationBot.UnregisterTrackedID:output_type -> messages.Ack
-	93,  // 155: mixmessages.UDB.RegisterUser:output_type -> messages.Ack
-	93,  // 156: mixmessages.UDB.RemoveUser:output_type -> messages.Ack
-	70,  // 157: mixmessages.UDB.RegisterFact:output_type -> mixmessages.FactRegisterResponse
-	93,  // 158: mixmessages.UDB.ConfirmFact:output_type -> messages.Ack
-	93,  // 159: mixmessages.UDB.RemoveFact:output_type -> messages.Ack
-	63,  // 160: mixmessages.UDB.RequestChannelLease:output_type -> mixmessages.ChannelLeaseResponse
-	65,  // 161: mixmessages.UDB.ValidateUsername:output_type -> mixmessages.UsernameValidation
-	93,  // 162: mixmessages.Authorizer.Authorize:output_type -> messages.Ack
-	93,  // 163: mixmessages.Authorizer.RequestCert:output_type -> messages.Ack
-	77,  // 164: mixmessages.Authorizer.RequestEABCredentials:output_type -> mixmessages.EABCredentialResponse
-	81,  // 165: mixmessages.RemoteSync.Login:output_type -> mixmessages.RsAuthenticationResponse
-	84,  // 166: mixmessages.RemoteSync.Read:output_type -> mixmessages.RsReadResponse
-	93,  // 167: mixmessages.RemoteSync.Write:output_type -> messages.Ack
-	87,  // 168: mixmessages.RemoteSync.GetLastModified:output_type -> mixmessages.RsTimestampResponse
-	87,  // 169: mixmessages.RemoteSync.GetLastWrite:output_type -> mixmessages.RsTimestampResponse
-	86,  // 170: mixmessages.RemoteSync.ReadDir:output_type -> mixmessages.RsReadDirResponse
-	113, // [113:171] is the sub-list for method output_type
-	55,  // [55:113] is the sub-list for method input_type
-	55,  // [55:55] is the sub-list for extension type_name
-	55,  // [55:55] is the sub-list for extension extendee
-	0,   // [0:55] is the sub-list for field type_name
+	52,  // 0: mixmessages.ClientKeyRequest.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	102, // 1: mixmessages.SignedClientBatchKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
+	102, // 2: mixmessages.SignedClientKeyRequest.ClientKeyRequestSignature:type_name -> messages.RSASignature
+	8,   // 3: mixmessages.SignedBatchKeyResponse.SignedKeys:type_name -> mixmessages.SignedKeyResponse
+	102, // 4: mixmessages.SignedKeyResponse.KeyResponseSignedByGateway:type_name -> messages.RSASignature
+	80,  // 5: mixmessages.RoundPublicKey.Round:type_name -> mixmessages.RoundInfo
+	80,  // 6: mixmessages.Batch.Round:type_name -> mixmessages.RoundInfo
+	32,  // 7: mixmessages.Batch.slots:type_name -> mixmessages.Slot
+	32,  // 8: mixmessages.CompletedBatch.slots:type_name -> mixmessages.Slot
+	80,  // 9: mixmessages.BatchInfo.Round:type_name -> mixmessages.RoundInfo
+	103, // 10: mixmessages.RoundTripPing.Payload:type_name -> google.protobuf.Any
+	80,  // 11: mixmessages.RoundTripPing.Round:type_name -> mixmessages.RoundInfo
+	47,  // 12: mixmessages.ServerPoll.Full:type_name -> mixmessages.NDFHash
+	47,  // 13: mixmessages.ServerPoll.Partial:type_name -> mixmessages.NDFHash
+	48,  // 14: mixmessages.ServerPollResponse.FullNDF:type_name -> mixmessages.NDF
+	48,  // 15: mixmessages.ServerPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	80,  // 16: mixmessages.ServerPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	80,  // 17: mixmessages.ServerPollResponse.BatchRequest:type_name -> mixmessages.RoundInfo
+	19,  // 18: mixmessages.ServerPollResponse.Batch:type_name -> mixmessages.BatchReady
+	102, // 19: mixmessages.SharePiece.Signature:type_name -> messages.RSASignature
+	80,  // 20: mixmessages.HistoricalRoundsResponse.Rounds:type_name -> mixmessages.RoundInfo
+	28,  // 21: mixmessages.GetMessagesBatch.Requests:type_name -> mixmessages.GetMessages
+	29,  // 22: mixmessages.GetMessagesResponseBatch.Results:type_name -> mixmessages.GetMessagesResponse
+	32,  // 23: mixmessages.GetMessagesResponse.Messages:type_name -> mixmessages.Slot
+	32,  // 24: mixmessages.RoundMessages.Messages:type_name -> mixmessages.Slot
+	47,  // 25: mixmessages.GatewayPoll.Partial:type_name -> mixmessages.NDFHash
+	48,  // 26: mixmessages.GatewayPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	80,  // 27: mixmessages.GatewayPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	37,  // 28: mixmessages.GatewayPollResponse.Filters:type_name -> mixmessages.ClientBlooms
+	47,  // 29: mixmessages.RoundSubscription.Partial:type_name -> mixmessages.NDFHash
+	48,  // 30: mixmessages.RoundSubscriptionUpdate.PartialNDF:type_name -> mixmessages.NDF
+	80,  // 31: mixmessages.RoundSubscriptionUpdate.Updates:type_name -> mixmessages.RoundInfo
+	38,  // 32: mixmessages.ClientBlooms.Filters:type_name -> mixmessages.ClientBloom
+	40,  // 33: mixmessages.GatewaySlots.Messages:type_name -> mixmessages.GatewaySlot
+	32,  // 34: mixmessages.GatewaySlot.Message:type_name -> mixmessages.Slot
+	42,  // 35: mixmessages.GatewaySlotResponse.SlotResults:type_name -> mixmessages.GatewaySlotResult
+	0,   // 36: mixmessages.GatewaySlotResult.Status:type_name -> mixmessages.GatewaySlotStatus
+	1,   // 37: mixmessages.GatewaySlotResult.Reason:type_name -> mixmessages.GatewaySlotRejection
+	102, // 38: mixmessages.NDF.Signature:type_name -> messages.RSASignature
+	102, // 39: mixmessages.SignedRegistrationConfirmation.RegistrarSignature:type_name -> messages.RSASignature
+	52,  // 40: mixmessages.SignedClientRegistrationConfirmations.ClientTransmissionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	52,  // 41: mixmessages.SignedClientRegistrationConfirmations.ClientReceptionConfirmation:type_name -> mixmessages.SignedRegistrationConfirmation
+	47,  // 42: mixmessages.PermissioningPoll.Full:type_name -> mixmessages.NDFHash
+	47,  // 43: mixmessages.PermissioningPoll.Partial:type_name -> mixmessages.NDFHash
+	81,  // 44: mixmessages.PermissioningPoll.Error:type_name -> mixmessages.RoundError
+	56,  // 45: mixmessages.PermissioningPoll.ClientErrors:type_name -> mixmessages.ClientError
+	48,  // 46: mixmessages.PermissionPollResponse.FullNDF:type_name -> mixmessages.NDF
+	48,  // 47: mixmessages.PermissionPollResponse.PartialNDF:type_name -> mixmessages.NDF
+	80,  // 48: mixmessages.PermissionPollResponse.Updates:type_name -> mixmessages.RoundInfo
+	62,  // 49: mixmessages.UnregisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
+	62,  // 50: mixmessages.RegisterTrackedIdRequest.Request:type_name -> mixmessages.TrackedIntermediaryIdRequest
+	67,  // 51: mixmessages.NotificationBatch.notifications:type_name -> mixmessages.NotificationData
+	73,  // 52: mixmessages.UDBUserRegistration.IdentityRegistration:type_name -> mixmessages.Identity
+	74,  // 53: mixmessages.UDBUserRegistration.frs:type_name -> mixmessages.FactRegisterRequest
+	75,  // 54: mixmessages.FactRegisterRequest.Fact:type_name -> mixmessages.Fact
+	75,  // 55: mixmessages.FactRemovalRequest.RemovalData:type_name -> mixmessages.Fact
+	81,  // 56: mixmessages.RoundInfo.Errors:type_name -> mixmessages.RoundError
+	56,  // 57: mixmessages.RoundInfo.ClientErrors:type_name -> mixmessages.ClientError
+	102, // 58: mixmessages.RoundInfo.Signature:type_name -> messages.RSASignature
+	104, // 59: mixmessages.RoundInfo.EccSignature:type_name -> messages.ECCSignature
+	102, // 60: mixmessages.RoundError.Signature:type_name -> messages.RSASignature
+	92,  // 61: mixmessages.RsWriteRequest.Precondition:type_name -> mixmessages.RsWritePrecondition
+	92,  // 62: mixmessages.RsDeleteRequest.Precondition:type_name -> mixmessages.RsWritePrecondition
+	92,  // 63: mixmessages.RsRenameRequest.Precondition:type_name -> mixmessages.RsWritePrecondition
+	91,  // 64: mixmessages.RsWriteBatchRequest.Writes:type_name -> mixmessages.RsWriteRequest
+	96,  // 65: mixmessages.RsWriteBatchRequest.Deletes:type_name -> mixmessages.RsDeleteRequest
+	2,   // 66: mixmessages.RsChangeEvent.Kind:type_name -> mixmessages.RsChangeKind
+	105, // 67: mixmessages.Node.AskOnline:input_type -> messages.AuthenticatedMessage
+	105, // 68: mixmessages.Node.CreateNewRound:input_type -> messages.AuthenticatedMessage
+	32,  // 69: mixmessages.Node.UploadUnmixedBatch:input_type -> mixmessages.Slot
+	32,  // 70: mixmessages.Node.FinishRealtime:input_type -> mixmessages.Slot
+	32,  // 71: mixmessages.Node.PrecompTestBatch:input_type -> mixmessages.Slot
+	105, // 72: mixmessages.Node.PostPhase:input_type -> messages.AuthenticatedMessage
+	32,  // 73: mixmessages.Node.StreamPostPhase:input_type -> mixmessages.Slot
+	105, // 74: mixmessages.Node.GetRoundBufferInfo:input_type -> messages.AuthenticatedMessage
+	105, // 75: mixmessages.Node.RequestClientKey:input_type -> messages.AuthenticatedMessage
+	105, // 76: mixmessages.Node.PostPrecompResult:input_type -> messages.AuthenticatedMessage
+	105, // 77: mixmessages.Node.GetMeasure:input_type -> messages.AuthenticatedMessage
+	105, // 78: mixmessages.Node.Poll:input_type -> messages.AuthenticatedMessage
+	105, // 79: mixmessages.Node.DownloadMixedBatch:input_type -> messages.AuthenticatedMessage
+	105, // 80: mixmessages.Node.SendRoundTripPing:input_type -> messages.AuthenticatedMessage
+	105, // 81: mixmessages.Node.RoundError:input_type -> messages.AuthenticatedMessage
+	106, // 82: mixmessages.Node.GetPermissioningAddress:input_type -> messages.Ping
+	105, // 83: mixmessages.Node.StartSharePhase:input_type -> messages.AuthenticatedMessage
+	105, // 84: mixmessages.Node.SharePhaseRound:input_type -> messages.AuthenticatedMessage
+	105, // 85: mixmessages.Node.ShareFinalKey:input_type -> messages.AuthenticatedMessage
+	5,   // 86: mixmessages.Gateway.RequestClientKey:input_type -> mixmessages.SignedClientKeyRequest
+	4,   // 87: mixmessages.Gateway.BatchNodeRegistration:input_type -> mixmessages.SignedClientBatchKeyRequest
+	40,  // 88: mixmessages.Gateway.PutMessage:input_type -> mixmessages.GatewaySlot
+	39,  // 89: mixmessages.Gateway.PutManyMessages:input_type -> mixmessages.GatewaySlots
+	105, // 90: mixmessages.Gateway.PutMessageProxy:input_type -> messages.AuthenticatedMessage
+	105, // 91: mixmessages.Gateway.PutManyMessagesProxy:input_type -> messages.AuthenticatedMessage
+	33,  // 92: mixmessages.Gateway.Poll:input_type -> mixmessages.GatewayPoll
+	24,  // 93: mixmessages.Gateway.RequestHistoricalRounds:input_type -> mixmessages.HistoricalRounds
+	28,  // 94: mixmessages.Gateway.RequestMessages:input_type -> mixmessages.GetMessages
+	26,  // 95: mixmessages.Gateway.RequestBatchMessages:input_type -> mixmessages.GetMessagesBatch
+	28,  // 96: mixmessages.Gateway.RequestMessagesStream:input_type -> mixmessages.GetMessages
+	26,  // 97: mixmessages.Gateway.RequestBatchMessagesStream:input_type -> mixmessages.GetMessagesBatch
+	21,  // 98: mixmessages.Gateway.RequestTlsCert:input_type -> mixmessages.RequestGatewayCert
+	35,  // 99: mixmessages.Gateway.SubscribeRounds:input_type -> mixmessages.RoundSubscription
+	32,  // 100: mixmessages.Gateway.ShareRoundMessages:input_type -> mixmessages.Slot
+	50,  // 101: mixmessages.ClientRegistrar.RegisterUser:input_type -> mixmessages.ClientRegistration
+	49,  // 102: mixmessages.Registration.RegisterNode:input_type -> mixmessages.NodeRegistration
+	47,  // 103: mixmessages.Registration.PollNdf:input_type -> mixmessages.NDFHash
+	105, // 104: mixmessages.Registration.Poll:input_type -> messages.AuthenticatedMessage
+	46,  // 105: mixmessages.Registration.CheckRegistration:input_type -> mixmessages.RegisteredNodeCheck
+	64,  // 106: mixmessages.NotificationBot.UnregisterForNotifications:input_type -> mixmessages.NotificationUnregisterRequest
+	63,  // 107: mixmessages.NotificationBot.RegisterForNotifications:input_type -> mixmessages.NotificationRegisterRequest
+	105, // 108: mixmessages.NotificationBot.ReceiveNotificationBatch:input_type -> messages.AuthenticatedMessage
+	58,  // 109: mixmessages.NotificationBot.RegisterToken:input_type -> mixmessages.RegisterTokenRequest
+	59,  // 110: mixmessages.NotificationBot.UnregisterToken:input_type -> mixmessages.UnregisterTokenRequest
+	61,  // 111: mixmessages.NotificationBot.RegisterTrackedID:input_type -> mixmessages.RegisterTrackedIdRequest
+	60,  // 112: mixmessages.NotificationBot.UnregisterTrackedID:input_type -> mixmessages.UnregisterTrackedIdRequest
+	72,  // 113: mixmessages.UDB.RegisterUser:input_type -> mixmessages.UDBUserRegistration
+	78,  // 114: mixmessages.UDB.RemoveUser:input_type -> mixmessages.FactRemovalRequest
+	74,  // 115: mixmessages.UDB.RegisterFact:input_type -> mixmessages.FactRegisterRequest
+	77,  // 116: mixmessages.UDB.ConfirmFact:input_type -> mixmessages.FactConfirmRequest
+	78,  // 117: mixmessages.UDB.RemoveFact:input_type -> mixmessages.FactRemovalRequest
+	68,  // 118: mixmessages.UDB.RequestChannelLease:input_type -> mixmessages.ChannelLeaseRequest
+	70,  // 119: mixmessages.UDB.ValidateUsername:input_type -> mixmessages.UsernameValidationRequest
+	85,  // 120: mixmessages.Authorizer.Authorize:input_type -> mixmessages.AuthorizerAuth
+	84,  // 121: mixmessages.Authorizer.RequestCert:input_type -> mixmessages.AuthorizerCertRequest
+	82,  // 122: mixmessages.Authorizer.RequestEABCredentials:input_type -> mixmessages.EABCredentialRequest
+	86,  // 123: mixmessages.RemoteSync.Login:input_type -> mixmessages.RsAuthenticationRequest
+	88,  // 124: mixmessages.RemoteSync.Read:input_type -> mixmessages.RsReadRequest
+	91,  // 125: mixmessages.RemoteSync.Write:input_type -> mixmessages.RsWriteRequest
+	88,  // 126: mixmessages.RemoteSync.GetLastModified:input_type -> mixmessages.RsReadRequest
+	89,  // 127: mixmessages.RemoteSync.GetLastWrite:input_type -> mixmessages.RsLastWriteRequest
+	88,  // 128: mixmessages.RemoteSync.ReadDir:input_type -> mixmessages.RsReadRequest
+	23,  // 129: mixmessages.RemoteSync.WriteStream:input_type -> mixmessages.StreamChunk
+	88,  // 130: mixmessages.RemoteSync.ReadStream:input_type -> mixmessages.RsReadRequest
+	100, // 131: mixmessages.RemoteSync.Watch:input_type -> mixmessages.RsWatchRequest
+	96,  // 132: mixmessages.RemoteSync.Delete:input_type -> mixmessages.RsDeleteRequest
+	88,  // 133: mixmessages.RemoteSync.Stat:input_type -> mixmessages.RsReadRequest
+	98,  // 134: mixmessages.RemoteSync.Rename:input_type -> mixmessages.RsRenameRequest
+	99,  // 135: mixmessages.RemoteSync.WriteBatch:input_type -> mixmessages.RsWriteBatchRequest
+	107, // 136: mixmessages.Node.AskOnline:output_type -> messages.Ack
+	107, // 137: mixmessages.Node.CreateNewRound:output_type -> messages.Ack
+	107, // 138: mixmessages.Node.UploadUnmixedBatch:output_type -> messages.Ack
+	107, // 139: mixmessages.Node.FinishRealtime:output_type -> messages.Ack
+	107, // 140: mixmessages.Node.PrecompTestBatch:output_type -> messages.Ack
+	107, // 141: mixmessages.Node.PostPhase:output_type -> messages.Ack
+	107, // 142: mixmessages.Node.StreamPostPhase:output_type -> messages.Ack
+	10,  // 143: mixmessages.Node.GetRoundBufferInfo:output_type -> mixmessages.RoundBufferInfo
+	8,   // 144: mixmessages.Node.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
+	107, // 145: mixmessages.Node.PostPrecompResult:output_type -> messages.Ack
+	12,  // 146: mixmessages.Node.GetMeasure:output_type -> mixmessages.RoundMetrics
+	18,  // 147: mixmessages.Node.Poll:output_type -> mixmessages.ServerPollResponse
+	32,  // 148: mixmessages.Node.DownloadMixedBatch:output_type -> mixmessages.Slot
+	107, // 149: mixmessages.Node.SendRoundTripPing:output_type -> messages.Ack
+	107, // 150: mixmessages.Node.RoundError:output_type -> messages.Ack
+	79,  // 151: mixmessages.Node.GetPermissioningAddress:output_type -> mixmessages.StrAddress
+	107, // 152: mixmessages.Node.StartSharePhase:output_type -> messages.Ack
+	107, // 153: mixmessages.Node.SharePhaseRound:output_type -> messages.Ack
+	107, // 154: mixmessages.Node.ShareFinalKey:output_type -> messages.Ack
+	8,   // 155: mixmessages.Gateway.RequestClientKey:output_type -> mixmessages.SignedKeyResponse
+	7,   // 156: mixmessages.Gateway.BatchNodeRegistration:output_type -> mixmessages.SignedBatchKeyResponse
+	41,  // 157: mixmessages.Gateway.PutMessage:output_type -> mixmessages.GatewaySlotResponse
+	41,  // 158: mixmessages.Gateway.PutManyMessages:output_type -> mixmessages.GatewaySlotResponse
+	41,  // 159: mixmessages.Gateway.PutMessageProxy:output_type -> mixmessages.GatewaySlotResponse
+	41,  // 160: mixmessages.Gateway.PutManyMessagesProxy:output_type -> mixmessages.GatewaySlotResponse
+	23,  // 161: mixmessages.Gateway.Poll:output_type -> mixmessages.StreamChunk
+	25,  // 162: mixmessages.Gateway.RequestHistoricalRounds:output_type -> mixmessages.HistoricalRoundsResponse
+	29,  // 163: mixmessages.Gateway.RequestMessages:output_type -> mixmessages.GetMessagesResponse
+	27,  // 164: mixmessages.Gateway.RequestBatchMessages:output_type -> mixmessages.GetMessagesResponseBatch
+	23,  // 165: mixmessages.Gateway.RequestMessagesStream:output_type -> mixmessages.StreamChunk
+	23,  // 166: mixmessages.Gateway.RequestBatchMessagesStream:output_type -> mixmessages.StreamChunk
+	22,  // 167: mixmessages.Gateway.RequestTlsCert:output_type -> mixmessages.GatewayCertificate
+	36,  // 168: mixmessages.Gateway.SubscribeRounds:output_type -> mixmessages.RoundSubscriptionUpdate
+	107, // 169: mixmessages.Gateway.ShareRoundMessages:output_type -> messages.Ack
+	53,  // 170: mixmessages.ClientRegistrar.RegisterUser:output_type -> mixmessages.SignedClientRegistrationConfirmations
+	107, // 171: mixmessages.Registration.RegisterNode:output_type -> messages.Ack
+	48,  // 172: mixmessages.Registration.PollNdf:output_type -> mixmessages.NDF
+	57,  // 173: mixmessages.Registration.Poll:output_type -> mixmessages.PermissionPollResponse
+	45,  // 174: mixmessages.Registration.CheckRegistration:output_type -> mixmessages.RegisteredNodeConfirmation
+	107, // 175: mixmessages.NotificationBot.UnregisterForNotifications:output_type -> messages.Ack
+	107, // 176: mixmessages.NotificationBot.RegisterForNotifications:output_type -> messages.Ack
+	107, // 177: mixmessages.NotificationBot.ReceiveNotificationBatch:output_type -> messages.Ack
+	107, // 178: mixmessages.NotificationBot.RegisterToken:output_type -> messages.Ack
+	107, // 179: mixmessages.NotificationBot.UnregisterToken:output_type -> messages.Ack
+	107, // 180: mixmessages.NotificationBot.RegisterTrackedID:output_type -> messages.Ack
+	107, // 181: mixmessages.NotificationBot.UnregisterTrackedID:output_type -> messages.Ack
+	107, // 182: mixmessages.UDB.RegisterUser:output_type -> messages.Ack
+	107, // 183: mixmessages.UDB.RemoveUser:output_type -> messages.Ack
+	76,  // 184: mixmessages.UDB.RegisterFact:output_type -> mixmessages.FactRegisterResponse
+	107, // 185: mixmessages.UDB.ConfirmFact:output_type -> messages.Ack
+	107, // 186: mixmessages.UDB.RemoveFact:output_type -> messages.Ack
+	69,  // 187: mixmessages.UDB.RequestChannelLease:output_type -> mixmessages.ChannelLeaseResponse
+	71,  // 188: mixmessages.UDB.ValidateUsername:output_type -> mixmessages.UsernameValidation
+	107, // 189: mixmessages.Authorizer.Authorize:output_type -> messages.Ack
+	107, // 190: mixmessages.Authorizer.RequestCert:output_type -> messages.Ack
+	83,  // 191: mixmessages.Authorizer.RequestEABCredentials:output_type -> mixmessages.EABCredentialResponse
+	87,  // 192: mixmessages.RemoteSync.Login:output_type -> mixmessages.RsAuthenticationResponse
+	90,  // 193: mixmessages.RemoteSync.Read:output_type -> mixmessages.RsReadResponse
+	107, // 194: mixmessages.RemoteSync.Write:output_type -> messages.Ack
+	95,  // 195: mixmessages.RemoteSync.GetLastModified:output_type -> mixmessages.RsTimestampResponse
+	95,  // 196: mixmessages.RemoteSync.GetLastWrite:output_type -> mixmessages.RsTimestampResponse
+	94,  // 197: mixmessages.RemoteSync.ReadDir:output_type -> mixmessages.RsReadDirResponse
+	107, // 198: mixmessages.RemoteSync.WriteStream:output_type -> messages.Ack
+	23,  // 199: mixmessages.RemoteSync.ReadStream:output_type -> mixmessages.StreamChunk
+	101, // 200: mixmessages.RemoteSync.Watch:output_type -> mixmessages.RsChangeEvent
+	107, // 201: mixmessages.RemoteSync.Delete:output_type -> messages.Ack
+	97,  // 202: mixmessages.RemoteSync.Stat:output_type -> mixmessages.RsStatResponse
+	107, // 203: mixmessages.RemoteSync.Rename:output_type -> messages.Ack
+	107, // 204: mixmessages.RemoteSync.WriteBatch:output_type -> messages.Ack
+	136, // [136:205] is the sub-list for method output_type
+	67,  // [67:136] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_mixmessages_proto_init() }
@@ -7620,7 +8777,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientBlooms); i {
+			switch v := v.(*RoundSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7632,7 +8789,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientBloom); i {
+			switch v := v.(*RoundSubscriptionUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7644,7 +8801,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlots); i {
+			switch v := v.(*ClientBlooms); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7656,7 +8813,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlot); i {
+			switch v := v.(*ClientBloom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7668,7 +8825,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewaySlotResponse); i {
+			switch v := v.(*GatewaySlots); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7680,7 +8837,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchSenders); i {
+			switch v := v.(*GatewaySlot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7692,7 +8849,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Recipients); i {
+			switch v := v.(*GatewaySlotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7704,7 +8861,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisteredNodeConfirmation); i {
+			switch v := v.(*GatewaySlotResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7716,7 +8873,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisteredNodeCheck); i {
+			switch v := v.(*BatchSenders); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7728,7 +8885,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NDFHash); i {
+			switch v := v.(*Recipients); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7740,7 +8897,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NDF); i {
+			switch v := v.(*RegisteredNodeConfirmation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7752,7 +8909,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeRegistration); i {
+			switch v := v.(*RegisteredNodeCheck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7764,7 +8921,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientRegistration); i {
+			switch v := v.(*NDFHash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7776,7 +8933,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientRegistrationConfirmation); i {
+			switch v := v.(*NDF); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7788,7 +8945,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedRegistrationConfirmation); i {
+			switch v := v.(*NodeRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7800,7 +8957,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedClientRegistrationConfirmations); i {
+			switch v := v.(*ClientRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7812,7 +8969,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientVersion); i {
+			switch v := v.(*ClientRegistrationConfirmation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7824,7 +8981,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PermissioningPoll); i {
+			switch v := v.(*SignedRegistrationConfirmation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7836,7 +8993,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientError); i {
+			switch v := v.(*SignedClientRegistrationConfirmations); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7848,7 +9005,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PermissionPollResponse); i {
+			switch v := v.(*ClientVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7860,7 +9017,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTokenRequest); i {
+			switch v := v.(*PermissioningPoll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7872,7 +9029,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterTokenRequest); i {
+			switch v := v.(*ClientError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7884,7 +9041,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnregisterTrackedIdRequest); i {
+			switch v := v.(*PermissionPollResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7896,7 +9053,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterTrackedIdRequest); i {
+			switch v := v.(*RegisterTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7908,7 +9065,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackedIntermediaryIdRequest); i {
+			switch v := v.(*UnregisterTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7920,7 +9077,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationRegisterRequest); i {
+			switch v := v.(*UnregisterTrackedIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7932,7 +9089,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationUnregisterRequest); i {
+			switch v := v.(*RegisterTrackedIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7944,7 +9101,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIdList); i {
+			switch v := v.(*TrackedIntermediaryIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7956,7 +9113,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationBatch); i {
+			switch v := v.(*NotificationRegisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7968,7 +9125,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationData); i {
+			switch v := v.(*NotificationUnregisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7980,7 +9137,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelLeaseRequest); i {
+			switch v := v.(*UserIdList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7992,7 +9149,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelLeaseResponse); i {
+			switch v := v.(*NotificationBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8004,7 +9161,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsernameValidationRequest); i {
+			switch v := v.(*NotificationData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8016,7 +9173,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsernameValidation); i {
+			switch v := v.(*ChannelLeaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8028,7 +9185,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UDBUserRegistration); i {
+			switch v := v.(*ChannelLeaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8040,7 +9197,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Identity); i {
+			switch v := v.(*UsernameValidationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8052,7 +9209,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactRegisterRequest); i {
+			switch v := v.(*UsernameValidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8064,7 +9221,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fact); i {
+			switch v := v.(*UDBUserRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8076,7 +9233,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactRegisterResponse); i {
+			switch v := v.(*Identity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8088,7 +9245,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactConfirmRequest); i {
+			switch v := v.(*FactRegisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8100,7 +9257,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FactRemovalRequest); i {
+			switch v := v.(*Fact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8112,7 +9269,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StrAddress); i {
+			switch v := v.(*FactRegisterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8124,7 +9281,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundInfo); i {
+			switch v := v.(*FactConfirmRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8136,7 +9293,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoundError); i {
+			switch v := v.(*FactRemovalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8148,7 +9305,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EABCredentialRequest); i {
+			switch v := v.(*StrAddress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8160,7 +9317,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EABCredentialResponse); i {
+			switch v := v.(*RoundInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8172,7 +9329,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizerCertRequest); i {
+			switch v := v.(*RoundError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8184,7 +9341,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizerAuth); i {
+			switch v := v.(*EABCredentialRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8196,7 +9353,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsAuthenticationRequest); i {
+			switch v := v.(*EABCredentialResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8208,7 +9365,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsAuthenticationResponse); i {
+			switch v := v.(*AuthorizerCertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8220,7 +9377,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsReadRequest); i {
+			switch v := v.(*AuthorizerAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8232,7 +9389,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsLastWriteRequest); i {
+			switch v := v.(*RsAuthenticationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8244,7 +9401,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsReadResponse); i {
+			switch v := v.(*RsAuthenticationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8256,7 +9413,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsWriteRequest); i {
+			switch v := v.(*RsReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8268,7 +9425,7 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsReadDirResponse); i {
+			switch v := v.(*RsLastWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8280,6 +9437,66 @@ func file_mixmessages_proto_init() {
 			}
 		}
 		file_mixmessages_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWritePrecondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWriteConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsReadDirResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsTimestampResponse); i {
 			case 0:
 				return &v.state
@@ -8291,19 +9508,92 @@ func file_mixmessages_proto_init() {
 				return nil
 			}
 		}
+		file_mixmessages_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsStatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsRenameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWriteBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixmessages_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsChangeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mixmessages_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   88,
+			NumEnums:      3,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   8,
 		},
 		GoTypes:           file_mixmessages_proto_goTypes,
 		DependencyIndexes: file_mixmessages_proto_depIdxs,
+		EnumInfos:         file_mixmessages_proto_enumTypes,
 		MessageInfos:      file_mixmessages_proto_msgTypes,
 	}.Build()
 	File_mixmessages_proto = out.File
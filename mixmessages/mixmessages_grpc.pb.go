@@ -987,7 +987,19 @@ type GatewayClient interface {
 	// Client -> Gateway message request
 	RequestMessages(ctx context.Context, in *GetMessages, opts ...grpc.CallOption) (*GetMessagesResponse, error)
 	RequestBatchMessages(ctx context.Context, in *GetMessagesBatch, opts ...grpc.CallOption) (*GetMessagesResponseBatch, error)
+	// Client -> Gateway message request, with the response streamed in chunks
+	RequestMessagesStream(ctx context.Context, in *GetMessages, opts ...grpc.CallOption) (Gateway_RequestMessagesStreamClient, error)
+	// Client -> Gateway batch message request, with the response streamed in
+	// chunks
+	RequestBatchMessagesStream(ctx context.Context, in *GetMessagesBatch, opts ...grpc.CallOption) (Gateway_RequestBatchMessagesStreamClient, error)
 	RequestTlsCert(ctx context.Context, in *RequestGatewayCert, opts ...grpc.CallOption) (*GatewayCertificate, error)
+	// Client -> Gateway subscription to round and NDF updates, streamed as the
+	// gateway receives them
+	SubscribeRounds(ctx context.Context, in *RoundSubscription, opts ...grpc.CallOption) (Gateway_SubscribeRoundsClient, error)
+	// Gateway -> Gateway replication of a completed round's messages within a
+	// team. The RoundMessages, without its messages, is sent in the stream
+	// header followed by each slot.
+	ShareRoundMessages(ctx context.Context, opts ...grpc.CallOption) (Gateway_ShareRoundMessagesClient, error)
 }
 
 type gatewayClient struct {
@@ -1111,6 +1123,70 @@ func (c *gatewayClient) RequestBatchMessages(ctx context.Context, in *GetMessage
 	return out, nil
 }
 
+func (c *gatewayClient) RequestMessagesStream(ctx context.Context, in *GetMessages, opts ...grpc.CallOption) (Gateway_RequestMessagesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[1], "/mixmessages.Gateway/RequestMessagesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayRequestMessagesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_RequestMessagesStreamClient interface {
+	Recv() (*StreamChunk, error)
+	grpc.ClientStream
+}
+
+type gatewayRequestMessagesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayRequestMessagesStreamClient) Recv() (*StreamChunk, error) {
+	m := new(StreamChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) RequestBatchMessagesStream(ctx context.Context, in *GetMessagesBatch, opts ...grpc.CallOption) (Gateway_RequestBatchMessagesStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[2], "/mixmessages.Gateway/RequestBatchMessagesStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayRequestBatchMessagesStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_RequestBatchMessagesStreamClient interface {
+	Recv() (*StreamChunk, error)
+	grpc.ClientStream
+}
+
+type gatewayRequestBatchMessagesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayRequestBatchMessagesStreamClient) Recv() (*StreamChunk, error) {
+	m := new(StreamChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gatewayClient) RequestTlsCert(ctx context.Context, in *RequestGatewayCert, opts ...grpc.CallOption) (*GatewayCertificate, error) {
 	out := new(GatewayCertificate)
 	err := c.cc.Invoke(ctx, "/mixmessages.Gateway/RequestTlsCert", in, out, opts...)
@@ -1120,6 +1196,72 @@ func (c *gatewayClient) RequestTlsCert(ctx context.Context, in *RequestGatewayCe
 	return out, nil
 }
 
+func (c *gatewayClient) SubscribeRounds(ctx context.Context, in *RoundSubscription, opts ...grpc.CallOption) (Gateway_SubscribeRoundsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[3], "/mixmessages.Gateway/SubscribeRounds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewaySubscribeRoundsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_SubscribeRoundsClient interface {
+	Recv() (*RoundSubscriptionUpdate, error)
+	grpc.ClientStream
+}
+
+type gatewaySubscribeRoundsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewaySubscribeRoundsClient) Recv() (*RoundSubscriptionUpdate, error) {
+	m := new(RoundSubscriptionUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) ShareRoundMessages(ctx context.Context, opts ...grpc.CallOption) (Gateway_ShareRoundMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[4], "/mixmessages.Gateway/ShareRoundMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayShareRoundMessagesClient{stream}
+	return x, nil
+}
+
+type Gateway_ShareRoundMessagesClient interface {
+	Send(*Slot) error
+	CloseAndRecv() (*messages.Ack, error)
+	grpc.ClientStream
+}
+
+type gatewayShareRoundMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayShareRoundMessagesClient) Send(m *Slot) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gatewayShareRoundMessagesClient) CloseAndRecv() (*messages.Ack, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(messages.Ack)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GatewayServer is the server API for Gateway service.
 // All implementations must embed UnimplementedGatewayServer
 // for forward compatibility
@@ -1144,7 +1286,19 @@ type GatewayServer interface {
 	// Client -> Gateway message request
 	RequestMessages(context.Context, *GetMessages) (*GetMessagesResponse, error)
 	RequestBatchMessages(context.Context, *GetMessagesBatch) (*GetMessagesResponseBatch, error)
+	// Client -> Gateway message request, with the response streamed in chunks
+	RequestMessagesStream(*GetMessages, Gateway_RequestMessagesStreamServer) error
+	// Client -> Gateway batch message request, with the response streamed in
+	// chunks
+	RequestBatchMessagesStream(*GetMessagesBatch, Gateway_RequestBatchMessagesStreamServer) error
 	RequestTlsCert(context.Context, *RequestGatewayCert) (*GatewayCertificate, error)
+	// Client -> Gateway subscription to round and NDF updates, streamed as the
+	// gateway receives them
+	SubscribeRounds(*RoundSubscription, Gateway_SubscribeRoundsServer) error
+	// Gateway -> Gateway replication of a completed round's messages within a
+	// team. The RoundMessages, without its messages, is sent in the stream
+	// header followed by each slot.
+	ShareRoundMessages(Gateway_ShareRoundMessagesServer) error
 	mustEmbedUnimplementedGatewayServer()
 }
 
@@ -1182,9 +1336,21 @@ func (UnimplementedGatewayServer) RequestMessages(context.Context, *GetMessages)
 func (UnimplementedGatewayServer) RequestBatchMessages(context.Context, *GetMessagesBatch) (*GetMessagesResponseBatch, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchMessages not implemented")
 }
+func (UnimplementedGatewayServer) RequestMessagesStream(*GetMessages, Gateway_RequestMessagesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RequestMessagesStream not implemented")
+}
+func (UnimplementedGatewayServer) RequestBatchMessagesStream(*GetMessagesBatch, Gateway_RequestBatchMessagesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method RequestBatchMessagesStream not implemented")
+}
 func (UnimplementedGatewayServer) RequestTlsCert(context.Context, *RequestGatewayCert) (*GatewayCertificate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestTlsCert not implemented")
 }
+func (UnimplementedGatewayServer) SubscribeRounds(*RoundSubscription, Gateway_SubscribeRoundsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeRounds not implemented")
+}
+func (UnimplementedGatewayServer) ShareRoundMessages(Gateway_ShareRoundMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShareRoundMessages not implemented")
+}
 func (UnimplementedGatewayServer) mustEmbedUnimplementedGatewayServer() {}
 
 // UnsafeGatewayServer may be embedded to opt out of forward compatibility for this service.
@@ -1381,6 +1547,48 @@ func _Gateway_RequestBatchMessages_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_RequestMessagesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMessages)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).RequestMessagesStream(m, &gatewayRequestMessagesStreamServer{stream})
+}
+
+type Gateway_RequestMessagesStreamServer interface {
+	Send(*StreamChunk) error
+	grpc.ServerStream
+}
+
+type gatewayRequestMessagesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayRequestMessagesStreamServer) Send(m *StreamChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_RequestBatchMessagesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetMessagesBatch)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).RequestBatchMessagesStream(m, &gatewayRequestBatchMessagesStreamServer{stream})
+}
+
+type Gateway_RequestBatchMessagesStreamServer interface {
+	Send(*StreamChunk) error
+	grpc.ServerStream
+}
+
+type gatewayRequestBatchMessagesStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayRequestBatchMessagesStreamServer) Send(m *StreamChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Gateway_RequestTlsCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RequestGatewayCert)
 	if err := dec(in); err != nil {
@@ -1399,6 +1607,53 @@ func _Gateway_RequestTlsCert_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_SubscribeRounds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RoundSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).SubscribeRounds(m, &gatewaySubscribeRoundsServer{stream})
+}
+
+type Gateway_SubscribeRoundsServer interface {
+	Send(*RoundSubscriptionUpdate) error
+	grpc.ServerStream
+}
+
+type gatewaySubscribeRoundsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewaySubscribeRoundsServer) Send(m *RoundSubscriptionUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_ShareRoundMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GatewayServer).ShareRoundMessages(&gatewayShareRoundMessagesServer{stream})
+}
+
+type Gateway_ShareRoundMessagesServer interface {
+	SendAndClose(*messages.Ack) error
+	Recv() (*Slot, error)
+	grpc.ServerStream
+}
+
+type gatewayShareRoundMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayShareRoundMessagesServer) SendAndClose(m *messages.Ack) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gatewayShareRoundMessagesServer) Recv() (*Slot, error) {
+	m := new(Slot)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Gateway_ServiceDesc is the grpc.ServiceDesc for Gateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1453,6 +1708,26 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Gateway_Poll_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RequestMessagesStream",
+			Handler:       _Gateway_RequestMessagesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RequestBatchMessagesStream",
+			Handler:       _Gateway_RequestBatchMessagesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeRounds",
+			Handler:       _Gateway_SubscribeRounds_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ShareRoundMessages",
+			Handler:       _Gateway_ShareRoundMessages_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "mixmessages.proto",
 }
@@ -2561,6 +2836,26 @@ type RemoteSyncClient interface {
 	GetLastModified(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsTimestampResponse, error)
 	GetLastWrite(ctx context.Context, in *RsLastWriteRequest, opts ...grpc.CallOption) (*RsTimestampResponse, error)
 	ReadDir(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsReadDirResponse, error)
+	// WriteStream writes data to a path, streamed as chunks of a serialized
+	// RsWriteRequest. The streaming header carries the total number of chunks
+	// and the hash of the data.
+	WriteStream(ctx context.Context, opts ...grpc.CallOption) (RemoteSync_WriteStreamClient, error)
+	// ReadStream reads data from a path, streamed as chunks of a serialized
+	// RsReadResponse. The streaming header carries the total number of chunks
+	// and the hash of the data.
+	ReadStream(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (RemoteSync_ReadStreamClient, error)
+	// Watch streams an event for each change made to a path starting with
+	// the requested prefix, as the changes happen.
+	Watch(ctx context.Context, in *RsWatchRequest, opts ...grpc.CallOption) (RemoteSync_WatchClient, error)
+	// Delete removes a path.
+	Delete(ctx context.Context, in *RsDeleteRequest, opts ...grpc.CallOption) (*messages.Ack, error)
+	// Stat returns the metadata of a path without its data.
+	Stat(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsStatResponse, error)
+	// Rename moves the data at a path to a new path.
+	Rename(ctx context.Context, in *RsRenameRequest, opts ...grpc.CallOption) (*messages.Ack, error)
+	// WriteBatch atomically applies a set of writes and deletes; either all
+	// of them are applied or none are.
+	WriteBatch(ctx context.Context, in *RsWriteBatchRequest, opts ...grpc.CallOption) (*messages.Ack, error)
 }
 
 type remoteSyncClient struct {
@@ -2625,6 +2920,140 @@ func (c *remoteSyncClient) ReadDir(ctx context.Context, in *RsReadRequest, opts
 	return out, nil
 }
 
+func (c *remoteSyncClient) WriteStream(ctx context.Context, opts ...grpc.CallOption) (RemoteSync_WriteStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RemoteSync_ServiceDesc.Streams[0], "/mixmessages.RemoteSync/WriteStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &remoteSyncWriteStreamClient{stream}
+	return x, nil
+}
+
+type RemoteSync_WriteStreamClient interface {
+	Send(*StreamChunk) error
+	CloseAndRecv() (*messages.Ack, error)
+	grpc.ClientStream
+}
+
+type remoteSyncWriteStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *remoteSyncWriteStreamClient) Send(m *StreamChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *remoteSyncWriteStreamClient) CloseAndRecv() (*messages.Ack, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(messages.Ack)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *remoteSyncClient) ReadStream(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (RemoteSync_ReadStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RemoteSync_ServiceDesc.Streams[1], "/mixmessages.RemoteSync/ReadStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &remoteSyncReadStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RemoteSync_ReadStreamClient interface {
+	Recv() (*StreamChunk, error)
+	grpc.ClientStream
+}
+
+type remoteSyncReadStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *remoteSyncReadStreamClient) Recv() (*StreamChunk, error) {
+	m := new(StreamChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *remoteSyncClient) Watch(ctx context.Context, in *RsWatchRequest, opts ...grpc.CallOption) (RemoteSync_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RemoteSync_ServiceDesc.Streams[2], "/mixmessages.RemoteSync/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &remoteSyncWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RemoteSync_WatchClient interface {
+	Recv() (*RsChangeEvent, error)
+	grpc.ClientStream
+}
+
+type remoteSyncWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *remoteSyncWatchClient) Recv() (*RsChangeEvent, error) {
+	m := new(RsChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *remoteSyncClient) Delete(ctx context.Context, in *RsDeleteRequest, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) Stat(ctx context.Context, in *RsReadRequest, opts ...grpc.CallOption) (*RsStatResponse, error) {
+	out := new(RsStatResponse)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/Stat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) Rename(ctx context.Context, in *RsRenameRequest, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSyncClient) WriteBatch(ctx context.Context, in *RsWriteBatchRequest, opts ...grpc.CallOption) (*messages.Ack, error) {
+	out := new(messages.Ack)
+	err := c.cc.Invoke(ctx, "/mixmessages.RemoteSync/WriteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RemoteSyncServer is the server API for RemoteSync service.
 // All implementations must embed UnimplementedRemoteSyncServer
 // for forward compatibility
@@ -2635,6 +3064,26 @@ type RemoteSyncServer interface {
 	GetLastModified(context.Context, *RsReadRequest) (*RsTimestampResponse, error)
 	GetLastWrite(context.Context, *RsLastWriteRequest) (*RsTimestampResponse, error)
 	ReadDir(context.Context, *RsReadRequest) (*RsReadDirResponse, error)
+	// WriteStream writes data to a path, streamed as chunks of a serialized
+	// RsWriteRequest. The streaming header carries the total number of chunks
+	// and the hash of the data.
+	WriteStream(RemoteSync_WriteStreamServer) error
+	// ReadStream reads data from a path, streamed as chunks of a serialized
+	// RsReadResponse. The streaming header carries the total number of chunks
+	// and the hash of the data.
+	ReadStream(*RsReadRequest, RemoteSync_ReadStreamServer) error
+	// Watch streams an event for each change made to a path starting with
+	// the requested prefix, as the changes happen.
+	Watch(*RsWatchRequest, RemoteSync_WatchServer) error
+	// Delete removes a path.
+	Delete(context.Context, *RsDeleteRequest) (*messages.Ack, error)
+	// Stat returns the metadata of a path without its data.
+	Stat(context.Context, *RsReadRequest) (*RsStatResponse, error)
+	// Rename moves the data at a path to a new path.
+	Rename(context.Context, *RsRenameRequest) (*messages.Ack, error)
+	// WriteBatch atomically applies a set of writes and deletes; either all
+	// of them are applied or none are.
+	WriteBatch(context.Context, *RsWriteBatchRequest) (*messages.Ack, error)
 	mustEmbedUnimplementedRemoteSyncServer()
 }
 
@@ -2660,6 +3109,27 @@ func (UnimplementedRemoteSyncServer) GetLastWrite(context.Context, *RsLastWriteR
 func (UnimplementedRemoteSyncServer) ReadDir(context.Context, *RsReadRequest) (*RsReadDirResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadDir not implemented")
 }
+func (UnimplementedRemoteSyncServer) WriteStream(RemoteSync_WriteStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WriteStream not implemented")
+}
+func (UnimplementedRemoteSyncServer) ReadStream(*RsReadRequest, RemoteSync_ReadStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadStream not implemented")
+}
+func (UnimplementedRemoteSyncServer) Watch(*RsWatchRequest, RemoteSync_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedRemoteSyncServer) Delete(context.Context, *RsDeleteRequest) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedRemoteSyncServer) Stat(context.Context, *RsReadRequest) (*RsStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stat not implemented")
+}
+func (UnimplementedRemoteSyncServer) Rename(context.Context, *RsRenameRequest) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedRemoteSyncServer) WriteBatch(context.Context, *RsWriteBatchRequest) (*messages.Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteBatch not implemented")
+}
 func (UnimplementedRemoteSyncServer) mustEmbedUnimplementedRemoteSyncServer() {}
 
 // UnsafeRemoteSyncServer may be embedded to opt out of forward compatibility for this service.
@@ -2781,6 +3251,146 @@ func _RemoteSync_ReadDir_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RemoteSync_WriteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RemoteSyncServer).WriteStream(&remoteSyncWriteStreamServer{stream})
+}
+
+type RemoteSync_WriteStreamServer interface {
+	SendAndClose(*messages.Ack) error
+	Recv() (*StreamChunk, error)
+	grpc.ServerStream
+}
+
+type remoteSyncWriteStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *remoteSyncWriteStreamServer) SendAndClose(m *messages.Ack) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *remoteSyncWriteStreamServer) Recv() (*StreamChunk, error) {
+	m := new(StreamChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RemoteSync_ReadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RsReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RemoteSyncServer).ReadStream(m, &remoteSyncReadStreamServer{stream})
+}
+
+type RemoteSync_ReadStreamServer interface {
+	Send(*StreamChunk) error
+	grpc.ServerStream
+}
+
+type remoteSyncReadStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *remoteSyncReadStreamServer) Send(m *StreamChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RemoteSync_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RsWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RemoteSyncServer).Watch(m, &remoteSyncWatchServer{stream})
+}
+
+type RemoteSync_WatchServer interface {
+	Send(*RsChangeEvent) error
+	grpc.ServerStream
+}
+
+type remoteSyncWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *remoteSyncWatchServer) Send(m *RsChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RemoteSync_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).Delete(ctx, req.(*RsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_Stat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).Stat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/Stat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).Stat(ctx, req.(*RsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsRenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).Rename(ctx, req.(*RsRenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSync_WriteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsWriteBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSyncServer).WriteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mixmessages.RemoteSync/WriteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSyncServer).WriteBatch(ctx, req.(*RsWriteBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RemoteSync_ServiceDesc is the grpc.ServiceDesc for RemoteSync service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2812,7 +3422,39 @@ var RemoteSync_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadDir",
 			Handler:    _RemoteSync_ReadDir_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _RemoteSync_Delete_Handler,
+		},
+		{
+			MethodName: "Stat",
+			Handler:    _RemoteSync_Stat_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _RemoteSync_Rename_Handler,
+		},
+		{
+			MethodName: "WriteBatch",
+			Handler:    _RemoteSync_WriteBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WriteStream",
+			Handler:       _RemoteSync_WriteStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReadStream",
+			Handler:       _RemoteSync_ReadStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _RemoteSync_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mixmessages.proto",
 }
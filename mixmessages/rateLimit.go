@@ -0,0 +1,58 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the error returned to senders of requests rejected by a rate limit
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+// rateLimitedErr is the message of the error returned by NewRateLimitedError.
+const rateLimitedErr = "%s rate limit exceeded, retry after %s"
+
+// NewRateLimitedError returns a ResourceExhausted gRPC status error for a
+// request rejected by the named rate limit. The error carries a RetryInfo
+// detail with the time the sender should wait before retrying, which can be
+// retrieved with GetRetryAfter.
+func NewRateLimitedError(limit string, retryAfter time.Duration) error {
+	s := status.Newf(codes.ResourceExhausted, rateLimitedErr, limit, retryAfter)
+	withDetails, err := s.WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return s.Err()
+	}
+	return withDetails.Err()
+}
+
+// GetRetryAfter returns the time to wait before retrying a request that was
+// rejected with an error returned by NewRateLimitedError. Returns false if the
+// error is not a ResourceExhausted gRPC status error with a retry delay.
+func GetRetryAfter(err error) (time.Duration, bool) {
+	var statusErr interface{ GRPCStatus() *status.Status }
+	if err == nil || !errors.As(err, &statusErr) {
+		return 0, false
+	}
+
+	s := statusErr.GRPCStatus()
+	if s.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+	for _, detail := range s.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			return retryInfo.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,44 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// Tests that GetRetryAfter returns the delay of an error returned by
+// NewRateLimitedError, including when it has been wrapped.
+func TestGetRetryAfter(t *testing.T) {
+	err := NewRateLimitedError("IP address", 3*time.Second)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Unexpected code: %s", status.Code(err))
+	}
+
+	for _, e := range []error{err, errors.WithMessage(err, "failed to send")} {
+		retryAfter, ok := GetRetryAfter(e)
+		if !ok || retryAfter != 3*time.Second {
+			t.Errorf("Unexpected retry after for %q: %s, %t", e, retryAfter, ok)
+		}
+	}
+}
+
+// Tests that GetRetryAfter returns false for errors that are not rate limited
+// errors.
+func TestGetRetryAfter_NotRateLimited(t *testing.T) {
+	for _, err := range []error{nil, errors.New("failed"),
+		status.Error(codes.ResourceExhausted, "no retry info"),
+		status.Error(codes.Unavailable, "unavailable")} {
+		if _, ok := GetRetryAfter(err); ok {
+			t.Errorf("Found retry after in %v", err)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains helper functions for remote sync messages
+
+package mixmessages
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/crypto/hash"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error messages.
+const (
+	dataHashDecodeErr     = "failed to decode data hash header: %+v"
+	dataHashMismatchErr   = "hash of received data %s does not match expected hash %s"
+	writeConflictErr      = "write to %q rejected: expected version %d, current version is %d"
+	duplicateBatchPathErr = "path %q appears more than once in write batch"
+)
+
+// HashRemoteSyncData returns the hash of the data of a file stored on a remote
+// sync server. It is used to verify the integrity of streamed data.
+func HashRemoteSyncData(data []byte) []byte {
+	h, err := hash.NewCMixHash()
+	if err != nil {
+		jww.FATAL.Panicf("Could not get hash: %+v", err)
+	}
+
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// HashRemoteSyncPassword returns the salted hash of the password, to be sent
+// in the PasswordHash field of an RsAuthenticationRequest. The salt itself is
+// not included and is sent in the Salt field.
+func HashRemoteSyncPassword(password, salt []byte) []byte {
+	h, err := hash.NewCMixHash()
+	if err != nil {
+		jww.FATAL.Panicf("Could not get hash: %+v", err)
+	}
+
+	h.Write(password)
+	h.Write(salt)
+	return h.Sum(nil)
+}
+
+// EncodeDataHashHeader returns the hash of the data encoded for use as the
+// value of a DataHashHeader.
+func EncodeDataHashHeader(data []byte) string {
+	return base64.StdEncoding.EncodeToString(HashRemoteSyncData(data))
+}
+
+// VerifyDataHashHeader checks that the data matches the hash in the value of
+// a DataHashHeader.
+func VerifyDataHashHeader(header string, data []byte) error {
+	expected, err := base64.StdEncoding.DecodeString(header)
+	if err != nil {
+		return errors.Errorf(dataHashDecodeErr, err)
+	}
+
+	received := HashRemoteSyncData(data)
+	if !bytes.Equal(expected, received) {
+		return errors.Errorf(dataHashMismatchErr,
+			base64.StdEncoding.EncodeToString(received), header)
+	}
+
+	return nil
+}
+
+// RsWriteConflictError is returned by a remote sync server when the
+// precondition of a write does not match the current state of the path. It is
+// sent to the client as a gRPC status error with code FailedPrecondition and
+// the RsWriteConflict as a detail, which can be recovered on the client using
+// GetRsWriteConflictError.
+type RsWriteConflictError struct {
+	Path            string
+	ExpectedVersion int64
+	CurrentVersion  int64
+}
+
+// Error returns the error message. Adheres to the error interface.
+func (e *RsWriteConflictError) Error() string {
+	return fmt.Sprintf(
+		writeConflictErr, e.Path, e.ExpectedVersion, e.CurrentVersion)
+}
+
+// GRPCStatus returns the gRPC status sent to the client when the error is
+// returned from a gRPC endpoint.
+func (e *RsWriteConflictError) GRPCStatus() *status.Status {
+	st := status.New(codes.FailedPrecondition, e.Error())
+	withDetails, err := st.WithDetails(&RsWriteConflict{
+		Path:           e.Path,
+		CurrentVersion: e.CurrentVersion,
+	})
+	if err != nil {
+		jww.ERROR.Printf("Failed to attach write conflict details: %+v", err)
+		return st
+	}
+	return withDetails
+}
+
+// GetRsWriteConflictError returns the RsWriteConflictError contained in the
+// error returned from a remote sync write, if there is one. The expected
+// version is not transmitted and is zero in the returned error.
+func GetRsWriteConflictError(err error) (*RsWriteConflictError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var conflictErr *RsWriteConflictError
+	if errors.As(err, &conflictErr) {
+		return conflictErr, true
+	}
+
+	st, ok := status.FromError(errors.Cause(err))
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if conflict, ok := detail.(*RsWriteConflict); ok {
+			return &RsWriteConflictError{
+				Path:           conflict.GetPath(),
+				CurrentVersion: conflict.GetCurrentVersion(),
+			}, true
+		}
+	}
+
+	return nil, false
+}
+
+// CheckPrecondition returns an RsWriteConflictError if the write request has a
+// precondition that does not match the current version of the path. A current
+// version of 0 indicates that the path does not exist. Returns nil if the
+// request has no precondition or if it is satisfied.
+func (m *RsWriteRequest) CheckPrecondition(currentVersion int64) error {
+	return checkPrecondition(m.GetPath(), m.GetPrecondition(), currentVersion)
+}
+
+// CheckPrecondition returns an RsWriteConflictError if the delete request has
+// a precondition that does not match the current version of the path. It
+// behaves the same as RsWriteRequest.CheckPrecondition.
+func (m *RsDeleteRequest) CheckPrecondition(currentVersion int64) error {
+	return checkPrecondition(m.GetPath(), m.GetPrecondition(), currentVersion)
+}
+
+// CheckPrecondition returns an RsWriteConflictError if the rename request has
+// a precondition that does not match the current version of the old path. It
+// behaves the same as RsWriteRequest.CheckPrecondition.
+func (m *RsRenameRequest) CheckPrecondition(currentVersion int64) error {
+	return checkPrecondition(m.GetOldPath(), m.GetPrecondition(), currentVersion)
+}
+
+// CheckPreconditions checks the precondition of every write and delete in the
+// batch against the current version of its path, as returned by
+// getVersion, and returns the first conflict. It also returns an error if a
+// path appears more than once in the batch.
+func (m *RsWriteBatchRequest) CheckPreconditions(
+	getVersion func(path string) int64) error {
+	paths := make(map[string]struct{}, len(m.GetWrites())+len(m.GetDeletes()))
+	addPath := func(path string) error {
+		if _, exists := paths[path]; exists {
+			return errors.Errorf(duplicateBatchPathErr, path)
+		}
+		paths[path] = struct{}{}
+		return nil
+	}
+
+	for _, w := range m.GetWrites() {
+		if err := addPath(w.GetPath()); err != nil {
+			return err
+		}
+		if err := w.CheckPrecondition(getVersion(w.GetPath())); err != nil {
+			return err
+		}
+	}
+	for _, d := range m.GetDeletes() {
+		if err := addPath(d.GetPath()); err != nil {
+			return err
+		}
+		if err := d.CheckPrecondition(getVersion(d.GetPath())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkPrecondition returns an RsWriteConflictError if the precondition is
+// set and does not match the current version.
+func checkPrecondition(path string, precondition *RsWritePrecondition,
+	currentVersion int64) error {
+	if precondition == nil {
+		return nil
+	}
+
+	expected := precondition.GetExpectedVersion()
+	if expected != currentVersion {
+		return &RsWriteConflictError{
+			Path:            path,
+			ExpectedVersion: expected,
+			CurrentVersion:  currentVersion,
+		}
+	}
+
+	return nil
+}
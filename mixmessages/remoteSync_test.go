@@ -0,0 +1,123 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package mixmessages
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/rand"
+	"testing"
+)
+
+// Tests that VerifyDataHashHeader accepts the header produced by
+// EncodeDataHashHeader for the same data.
+func TestVerifyDataHashHeader(t *testing.T) {
+	data := make([]byte, 5*ChunkSize)
+	rand.New(rand.NewSource(42)).Read(data)
+
+	header := EncodeDataHashHeader(data)
+	if err := VerifyDataHashHeader(header, data); err != nil {
+		t.Errorf("Failed to verify data hash header: %+v", err)
+	}
+}
+
+// Error path: Tests that VerifyDataHashHeader returns an error when the data
+// has been modified.
+func TestVerifyDataHashHeader_MismatchError(t *testing.T) {
+	data := []byte("remote sync data")
+	header := EncodeDataHashHeader(data)
+
+	data[0]++
+	if err := VerifyDataHashHeader(header, data); err == nil {
+		t.Errorf("Failed to detect modified data.")
+	}
+}
+
+// Error path: Tests that VerifyDataHashHeader returns an error when the header
+// is not valid base 64.
+func TestVerifyDataHashHeader_DecodeError(t *testing.T) {
+	if err := VerifyDataHashHeader("invalid base 64!", nil); err == nil {
+		t.Errorf("Failed to get error for invalid header.")
+	}
+}
+
+// Tests that the RsWriteConflictError returned by
+// RsWriteRequest.CheckPrecondition survives conversion to and from a gRPC
+// status and is recovered by GetRsWriteConflictError.
+func TestRsWriteRequest_CheckPrecondition_Conflict(t *testing.T) {
+	msg := &RsWriteRequest{
+		Path:         "path",
+		Precondition: &RsWritePrecondition{ExpectedVersion: 3},
+	}
+
+	err := msg.CheckPrecondition(5)
+	if err == nil {
+		t.Fatalf("Failed to detect version mismatch.")
+	}
+
+	// Simulate the error being sent over gRPC
+	st, _ := status.FromError(err)
+	received := errors.WithMessage(st.Err(), "wrapped")
+
+	conflict, ok := GetRsWriteConflictError(received)
+	if !ok {
+		t.Fatalf("Failed to get conflict from error: %+v", received)
+	}
+	if st.Code() != codes.FailedPrecondition {
+		t.Errorf("Unexpected code.\nexpected: %s\nreceived: %s",
+			codes.FailedPrecondition, st.Code())
+	}
+	if conflict.Path != "path" || conflict.CurrentVersion != 5 {
+		t.Errorf("Unexpected conflict: %+v", conflict)
+	}
+}
+
+// Tests that RsWriteRequest.CheckPrecondition returns nil when there is no
+// precondition or the precondition is satisfied.
+func TestRsWriteRequest_CheckPrecondition(t *testing.T) {
+	if err := (&RsWriteRequest{}).CheckPrecondition(7); err != nil {
+		t.Errorf("Unexpected error without precondition: %+v", err)
+	}
+
+	msg := &RsWriteRequest{Precondition: &RsWritePrecondition{}}
+	if err := msg.CheckPrecondition(0); err != nil {
+		t.Errorf("Unexpected error for new path: %+v", err)
+	}
+	if _, ok := GetRsWriteConflictError(msg.CheckPrecondition(1)); !ok {
+		t.Errorf("Failed to detect that path already exists.")
+	}
+}
+
+// Tests that RsWriteBatchRequest.CheckPreconditions returns the conflict for
+// the first failing operation and an error for duplicate paths.
+func TestRsWriteBatchRequest_CheckPreconditions(t *testing.T) {
+	versions := map[string]int64{"a": 1, "b": 2}
+	getVersion := func(path string) int64 { return versions[path] }
+
+	batch := &RsWriteBatchRequest{
+		Writes: []*RsWriteRequest{{Path: "a",
+			Precondition: &RsWritePrecondition{ExpectedVersion: 1}}},
+		Deletes: []*RsDeleteRequest{{Path: "b",
+			Precondition: &RsWritePrecondition{ExpectedVersion: 2}}},
+	}
+	if err := batch.CheckPreconditions(getVersion); err != nil {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+
+	versions["b"] = 3
+	conflict, ok := GetRsWriteConflictError(batch.CheckPreconditions(getVersion))
+	if !ok || conflict.Path != "b" || conflict.CurrentVersion != 3 {
+		t.Errorf("Unexpected conflict: %+v", conflict)
+	}
+
+	batch.Deletes[0].Path = "a"
+	if err := batch.CheckPreconditions(getVersion); err == nil {
+		t.Errorf("Failed to detect duplicate path.")
+	}
+}
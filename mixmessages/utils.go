@@ -16,11 +16,14 @@ import (
 
 // Headers for streaming
 const (
-	PostPhaseHeader        = "batchinfo"
-	UnmixedBatchHeader     = "unmixedbatchinfo"
-	MixedBatchHeader       = "mixedBatchInfo"
-	FinishRealtimeHeader   = "finishRealtimeRoundInfo"
-	PrecompTestBatchHeader = "precompTestBatch"
+	PostPhaseHeader         = "batchinfo"
+	UnmixedBatchHeader      = "unmixedbatchinfo"
+	MixedBatchHeader        = "mixedBatchInfo"
+	FinishRealtimeHeader    = "finishRealtimeRoundInfo"
+	PrecompTestBatchHeader  = "precompTestBatch"
+	WatchOpenedHeader       = "watchOpened"
+	RoundSubscriptionHeader = "roundSubscriptionOpened"
+	RoundMessagesHeader     = "roundMessagesInfo"
 )
 
 const NoStreamingHeaderErr = "Streaming header has no information from %s"
@@ -28,11 +31,23 @@ const NoStreamingHeaderErr = "Streaming header has no information from %s"
 // ChunkSize is the size of a streaming chunk in bytes.
 const ChunkSize = 1250
 
+// MaxChunkedDataSize is the maximum size in bytes of the data received in
+// chunks, and of that data once decompressed. Larger streams are rejected.
+const MaxChunkedDataSize = 256 << 20
+
+// maxChunks is the number of chunks needed to send MaxChunkedDataSize bytes.
+const maxChunks = (MaxChunkedDataSize + ChunkSize - 1) / ChunkSize
+
 // ChunkHeader is the header used for by a gateway
 // streaming its response for client poll. This is used for streaming
 // the amount of chunks the response has been split into.
 const ChunkHeader = "totalChunks"
 
+// DataHashHeader is the streaming header used to send the base 64 encoded
+// hash of the data being streamed, allowing the receiver to verify the
+// integrity of the assembled data.
+const DataHashHeader = "dataHash"
+
 // SplitResponseIntoChunks is a function which takes in a message and splits
 // the serialized message into ChunkSize chunks. .
 func SplitResponseIntoChunks(message proto.Message) ([]*StreamChunk, error) {
@@ -41,6 +56,11 @@ func SplitResponseIntoChunks(message proto.Message) ([]*StreamChunk, error) {
 		return nil, err
 	}
 
+	return splitIntoChunks(data), nil
+}
+
+// splitIntoChunks splits the data into ChunkSize chunks, numbered in order.
+func splitIntoChunks(data []byte) []*StreamChunk {
 	// Go will round down on integer division, the arithmetic below
 	// ensures the division rounds up
 	chunks := make([]*StreamChunk, 0, (len(data)+ChunkSize-1)/ChunkSize)
@@ -49,16 +69,30 @@ func SplitResponseIntoChunks(message proto.Message) ([]*StreamChunk, error) {
 		if end > len(data) {
 			end = len(data)
 		}
-		chunks = append(chunks, &StreamChunk{Datum: data[loc:end]})
+		chunks = append(chunks, &StreamChunk{
+			Datum:    data[loc:end],
+			Sequence: uint64(len(chunks)),
+		})
 	}
 
-	return chunks, nil
+	return chunks
 }
 
 // AssembleChunksIntoResponse takes a list of StreamChunk's and assembles
 // the datum into the message type expected by the caller.
-// This functions acts as the inverse of SplitResponseIntoChunks.
+// This functions acts as the inverse of SplitResponseIntoChunks. Use
+// AssembleCompressedChunksIntoResponse for compressed chunks.
 func AssembleChunksIntoResponse(chunks []*StreamChunk, response proto.Message) error {
+	return proto.Unmarshal(assembleChunks(chunks), response)
+}
+
+// assembleChunks concatenates the data of the chunks.
+func assembleChunks(chunks []*StreamChunk) []byte {
+	// An empty message is serialized into no chunks
+	if len(chunks) == 0 {
+		return nil
+	}
+
 	// Get the length of the last chunk packet
 	lastChunkLen := len(chunks[len(chunks)-1].Datum)
 
@@ -73,7 +107,7 @@ func AssembleChunksIntoResponse(chunks []*StreamChunk, response proto.Message) e
 		data = append(data, chunk.Datum...)
 	}
 
-	return proto.Unmarshal(data, response)
+	return data
 }
 
 func DebugMode() {
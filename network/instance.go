@@ -64,6 +64,9 @@ type Instance struct {
 	removeNode    chan *id.ID
 	addGateway    chan NodeGateway
 	removeGateway chan *id.ID
+
+	// Subscribers to round and NDF updates
+	roundSubscriptions roundSubscriptions
 }
 
 // Object used to signal information about the network health
@@ -311,6 +314,8 @@ func (i *Instance) UpdatePartialNdf(m *pb.NDF) error {
 		return errors.WithMessage(err, "Unable to update e2e group")
 	}
 
+	i.roundSubscriptions.publishNdf(i.partial.GetPb(), i.partial.GetHash())
+
 	return nil
 }
 
@@ -547,6 +552,11 @@ func (i *Instance) RoundUpdate(info *pb.RoundInfo) (*ds.Round, error) {
 		_ = i.ers.Store(info)
 	}
 
+	// Publish the info as received, which strict instances have already
+	// validated, so that lazy instances do not verify rounds that are never
+	// retrieved
+	i.roundSubscriptions.publishRound(info)
+
 	return rnd, nil
 }
 
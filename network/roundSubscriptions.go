@@ -0,0 +1,167 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the subscriptions to round and NDF updates received by an Instance,
+// used by gateways to serve SubscribeRounds streams
+
+package network
+
+import (
+	"bytes"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/primitives/states"
+	"sync"
+)
+
+// RoundSubscriptionBufLen is the number of updates buffered for each round
+// subscription. If a subscriber falls this far behind, its channel is closed
+// so that receiving updates never blocks the Instance.
+const RoundSubscriptionBufLen = 256
+
+// roundSubscription is a single subscriber to round and NDF updates.
+type roundSubscription struct {
+	updates     chan *pb.RoundSubscriptionUpdate
+	fastPolling bool
+
+	// lastUpdate is the ID of the latest round update sent and ndfHash is the
+	// hash of the latest partial NDF sent
+	lastUpdate uint64
+	ndfHash    []byte
+}
+
+// roundSubscriptions tracks all subscribers of an Instance. The zero value is
+// ready to use.
+type roundSubscriptions struct {
+	list map[*roundSubscription]struct{}
+	mux  sync.Mutex
+}
+
+// SubscribeRounds returns a channel that receives the round updates after
+// msg.LastUpdate and the partial NDF, if its hash differs from the one in the
+// message, followed by every round update and partial NDF the Instance
+// receives afterwards. If msg.FastPolling is set, only updates for rounds that
+// are QUEUED, COMPLETED or FAILED are sent.
+//
+// The returned function cancels the subscription and closes the channel. The
+// channel is also closed if the subscriber falls more than
+// RoundSubscriptionBufLen updates behind, in which case the caller should
+// subscribe again from the last update it received.
+func (i *Instance) SubscribeRounds(msg *pb.RoundSubscription) (
+	<-chan *pb.RoundSubscriptionUpdate, func()) {
+	rs := &i.roundSubscriptions
+	rs.mux.Lock()
+	defer rs.mux.Unlock()
+
+	sub := &roundSubscription{
+		updates:     make(chan *pb.RoundSubscriptionUpdate, RoundSubscriptionBufLen),
+		fastPolling: msg.GetFastPolling(),
+		lastUpdate:  msg.GetLastUpdate(),
+		ndfHash:     msg.GetPartial().GetHash(),
+	}
+
+	// Queue the updates the subscriber has missed. The lock is held so that
+	// no update received in the meantime is lost or sent twice.
+	catchUp := &pb.RoundSubscriptionUpdate{
+		Updates: sub.filter(i.GetRoundUpdates(int(msg.GetLastUpdate()))),
+	}
+	if i.partial != nil && !i.partial.CompareHash(sub.ndfHash) {
+		catchUp.PartialNDF = i.partial.GetPb()
+		sub.ndfHash = i.partial.GetHash()
+	}
+	if len(catchUp.Updates) > 0 || catchUp.PartialNDF != nil {
+		sub.updates <- catchUp
+	}
+
+	if rs.list == nil {
+		rs.list = make(map[*roundSubscription]struct{})
+	}
+	rs.list[sub] = struct{}{}
+
+	return sub.updates, func() { rs.remove(sub) }
+}
+
+// remove cancels the subscription and closes its channel if it is still
+// registered.
+func (rs *roundSubscriptions) remove(sub *roundSubscription) {
+	rs.mux.Lock()
+	defer rs.mux.Unlock()
+	rs.removeUnsafe(sub)
+}
+
+// removeUnsafe removes the subscription without taking the lock.
+func (rs *roundSubscriptions) removeUnsafe(sub *roundSubscription) {
+	if _, exists := rs.list[sub]; exists {
+		delete(rs.list, sub)
+		close(sub.updates)
+	}
+}
+
+// publishRound sends the round to every subscriber that has not yet received
+// it and wants it. The round info is sent as is, without verifying its
+// signature.
+func (rs *roundSubscriptions) publishRound(info *pb.RoundInfo) {
+	rs.mux.Lock()
+	defer rs.mux.Unlock()
+
+	for sub := range rs.list {
+		updates := sub.filter([]*pb.RoundInfo{info})
+		if len(updates) > 0 {
+			rs.send(sub, &pb.RoundSubscriptionUpdate{Updates: updates})
+		}
+	}
+}
+
+// publishNdf sends the partial NDF to every subscriber that has not yet
+// received it.
+func (rs *roundSubscriptions) publishNdf(ndf *pb.NDF, hash []byte) {
+	rs.mux.Lock()
+	defer rs.mux.Unlock()
+
+	for sub := range rs.list {
+		if !bytes.Equal(sub.ndfHash, hash) {
+			sub.ndfHash = hash
+			rs.send(sub, &pb.RoundSubscriptionUpdate{PartialNDF: ndf})
+		}
+	}
+}
+
+// send queues the update for the subscriber without blocking, removing the
+// subscriber if its buffer is full. Must be called while holding the lock.
+func (rs *roundSubscriptions) send(
+	sub *roundSubscription, update *pb.RoundSubscriptionUpdate) {
+	select {
+	case sub.updates <- update:
+	default:
+		jww.WARN.Printf("Round subscription fell more than %d updates "+
+			"behind; closing it", RoundSubscriptionBufLen)
+		rs.removeUnsafe(sub)
+	}
+}
+
+// filter returns the round updates newer than the last one sent to the
+// subscriber that it wants, and marks them as sent.
+func (sub *roundSubscription) filter(rounds []*pb.RoundInfo) []*pb.RoundInfo {
+	filtered := make([]*pb.RoundInfo, 0, len(rounds))
+	for _, info := range rounds {
+		if info.GetUpdateID() <= sub.lastUpdate {
+			continue
+		}
+		sub.lastUpdate = info.GetUpdateID()
+
+		if sub.fastPolling {
+			switch states.Round(info.GetState()) {
+			case states.QUEUED, states.COMPLETED, states.FAILED:
+			default:
+				continue
+			}
+		}
+		filtered = append(filtered, info)
+	}
+
+	return filtered
+}
@@ -0,0 +1,100 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package network
+
+import (
+	"gitlab.com/elixxir/comms/mixmessages"
+	ds "gitlab.com/elixxir/comms/network/dataStructures"
+	"gitlab.com/elixxir/comms/testutils"
+	"gitlab.com/elixxir/primitives/states"
+	"testing"
+)
+
+// newTestRound returns a signed round with the update ID and state.
+func newTestRound(t *testing.T, updateID uint64, state states.Round) *ds.Round {
+	pubKey, err := testutils.LoadPublicKeyTesting(t)
+	if err != nil {
+		t.Fatalf("Failed to load public key: %v", err)
+	}
+	ri := &mixmessages.RoundInfo{ID: updateID, UpdateID: updateID,
+		State: uint32(state), Timestamps: make([]uint64, states.NUM_STATES)}
+	if err = testutils.SignRoundInfoRsa(ri, t); err != nil {
+		t.Fatalf("Cannot sign round info: %v", err)
+	}
+	return ds.NewRound(ri, pubKey, nil)
+}
+
+// Tests that Instance.SubscribeRounds sends the missed updates followed by new
+// ones, filtering by state when FastPolling is set.
+func TestInstance_SubscribeRounds(t *testing.T) {
+	i := Instance{roundUpdates: ds.NewUpdates()}
+	for id, state := range []states.Round{
+		states.QUEUED, states.QUEUED, states.REALTIME, states.COMPLETED} {
+		_ = i.roundUpdates.AddRound(newTestRound(t, uint64(id+1), state))
+	}
+
+	updates, cancel := i.SubscribeRounds(
+		&mixmessages.RoundSubscription{LastUpdate: 1, FastPolling: true})
+
+	catchUp := <-updates
+	if len(catchUp.Updates) != 2 || catchUp.Updates[0].UpdateID != 2 ||
+		catchUp.Updates[1].UpdateID != 4 {
+		t.Errorf("Unexpected catch up updates: %v", catchUp.Updates)
+	}
+
+	// Updates that were already sent or filtered out are not sent
+	i.roundSubscriptions.publishRound(newTestRound(t, 4, states.COMPLETED).Get())
+	i.roundSubscriptions.publishRound(newTestRound(t, 5, states.PRECOMPUTING).Get())
+	i.roundSubscriptions.publishRound(newTestRound(t, 6, states.FAILED).Get())
+	update := <-updates
+	if len(update.Updates) != 1 || update.Updates[0].UpdateID != 6 {
+		t.Errorf("Unexpected update: %v", update.Updates)
+	}
+
+	cancel()
+	if _, ok := <-updates; ok {
+		t.Errorf("Channel not closed after subscription was cancelled.")
+	}
+}
+
+// Tests that a subscription that falls too far behind is closed.
+func TestInstance_SubscribeRounds_Overflow(t *testing.T) {
+	i := Instance{roundUpdates: ds.NewUpdates()}
+	updates, cancel := i.SubscribeRounds(&mixmessages.RoundSubscription{})
+	defer cancel()
+
+	for id := uint64(1); id <= RoundSubscriptionBufLen+1; id++ {
+		i.roundSubscriptions.publishRound(newTestRound(t, id, states.QUEUED).Get())
+	}
+
+	for range updates {
+	}
+	if len(i.roundSubscriptions.list) != 0 {
+		t.Errorf("Subscription not removed after overflow.")
+	}
+}
+
+// Tests that Instance.RoundUpdate on a lazy instance publishes rounds to
+// subscribers without verifying them, which would panic on a bad signature.
+func TestInstance_RoundUpdate_LazyPublish(t *testing.T) {
+	i, _ := setupComm(t)
+	i.validationLevel = Lazy
+	updates, cancel := i.SubscribeRounds(&mixmessages.RoundSubscription{})
+	defer cancel()
+
+	ri := &mixmessages.RoundInfo{ID: 2, UpdateID: 2,
+		Timestamps: make([]uint64, states.NUM_STATES)}
+	if _, err := i.RoundUpdate(ri); err != nil {
+		t.Fatalf("Failed to add round: %+v", err)
+	}
+
+	update := <-updates
+	if len(update.Updates) != 1 || update.Updates[0] != ri {
+		t.Errorf("Unexpected update: %v", update.Updates)
+	}
+}
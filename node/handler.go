@@ -11,6 +11,7 @@ package node
 
 import (
 	jww "github.com/spf13/jwalterweatherman"
+	"gitlab.com/elixxir/comms/drain"
 	"gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/interconnect"
@@ -18,6 +19,7 @@ import (
 	"gitlab.com/xx_network/primitives/id"
 	"runtime/debug"
 	"strconv"
+	"time"
 )
 
 // Server object used to implement endpoints and top-level comms functionality
@@ -26,6 +28,9 @@ type Comms struct {
 	handler Handler
 	*mixmessages.UnimplementedNodeServer
 	*messages.UnimplementedGenericServer
+
+	// drainer tracks requests in flight so the node can be drained
+	drainer drain.Tracker
 }
 
 // Starts a new server on the address:port specified by listeningAddr
@@ -44,8 +49,9 @@ func StartNode(id *id.ID, localServer string, interconnectPort int, handler Hand
 		handler:    handler,
 	}
 	// Register GRPC services to the listening address
-	mixmessages.RegisterNodeServer(mixmessageServer.GetServer(), &mixmessageServer)
-	messages.RegisterGenericServer(mixmessageServer.GetServer(), &mixmessageServer)
+	grpcServer := mixmessageServer.drainer.Registrar(mixmessageServer.GetServer())
+	mixmessages.RegisterNodeServer(grpcServer, &mixmessageServer)
+	messages.RegisterGenericServer(grpcServer, &mixmessageServer)
 
 	// Start up interconnect service
 	if interconnectPort != 0 {
@@ -60,6 +66,17 @@ func StartNode(id *id.ID, localServer string, interconnectPort int, handler Hand
 	return &mixmessageServer
 }
 
+// Drain stops the node from accepting new requests and waits up to the
+// timeout for requests in flight, including batch streams, to complete. New
+// requests are rejected with an Unavailable error for which
+// drain.IsDrainingError returns true. If progress is not nil, it is called
+// with the number of requests in flight when draining starts and each time one
+// completes. Returns an error if requests are still in flight at the timeout.
+// Intended for use before Shutdown.
+func (s *Comms) Drain(timeout time.Duration, progress func(inFlight int)) error {
+	return s.drainer.Drain(timeout, progress)
+}
+
 type Handler interface {
 	// Server interface for starting New Rounds
 	CreateNewRound(message *mixmessages.RoundInfo, auth *connect.Auth) error
@@ -0,0 +1,188 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the middleware wrapper for the Node Handler
+
+package node
+
+import (
+	"gitlab.com/elixxir/comms/middleware"
+	"gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/interconnect"
+)
+
+// WithMiddleware returns a Handler that calls each method of the handler
+// through the middleware chain. The endpoint name of each call is the name of
+// the Handler method. For streaming endpoints, the latency seen by After hooks
+// covers the whole stream.
+func WithMiddleware(handler Handler, chain middleware.Chain) Handler {
+	return &middlewareHandler{handler: handler, chain: chain}
+}
+
+// middlewareHandler wraps a Handler with a middleware.Chain.
+type middlewareHandler struct {
+	handler Handler
+	chain   middleware.Chain
+}
+
+// invoke calls the handle function for the endpoint through the chain.
+func (m *middlewareHandler) invoke(endpoint string, request interface{},
+	auth *connect.Auth, handle func() error) error {
+	return m.chain.Invoke(&middleware.Call{
+		Endpoint: endpoint, Request: request, Auth: auth}, handle)
+}
+
+func (m *middlewareHandler) CreateNewRound(message *mixmessages.RoundInfo,
+	auth *connect.Auth) error {
+	return m.invoke("CreateNewRound", message, auth, func() error {
+		return m.handler.CreateNewRound(message, auth)
+	})
+}
+
+func (m *middlewareHandler) UploadUnmixedBatch(
+	server mixmessages.Node_UploadUnmixedBatchServer, auth *connect.Auth) error {
+	return m.invoke("UploadUnmixedBatch", nil, auth, func() error {
+		return m.handler.UploadUnmixedBatch(server, auth)
+	})
+}
+
+func (m *middlewareHandler) DownloadMixedBatch(
+	stream mixmessages.Node_DownloadMixedBatchServer,
+	batchInfo *mixmessages.BatchReady, auth *connect.Auth) error {
+	return m.invoke("DownloadMixedBatch", batchInfo, auth, func() error {
+		return m.handler.DownloadMixedBatch(stream, batchInfo, auth)
+	})
+}
+
+func (m *middlewareHandler) FinishRealtime(message *mixmessages.RoundInfo,
+	streamServer mixmessages.Node_FinishRealtimeServer, auth *connect.Auth) error {
+	return m.invoke("FinishRealtime", message, auth, func() error {
+		return m.handler.FinishRealtime(message, streamServer, auth)
+	})
+}
+
+func (m *middlewareHandler) GetRoundBufferInfo(auth *connect.Auth) (
+	available int, err error) {
+	err = m.invoke("GetRoundBufferInfo", nil, auth, func() error {
+		available, err = m.handler.GetRoundBufferInfo(auth)
+		return err
+	})
+	return available, err
+}
+
+func (m *middlewareHandler) PrecompTestBatch(
+	stream mixmessages.Node_PrecompTestBatchServer,
+	info *mixmessages.RoundInfo, auth *connect.Auth) error {
+	return m.invoke("PrecompTestBatch", info, auth, func() error {
+		return m.handler.PrecompTestBatch(stream, info, auth)
+	})
+}
+
+func (m *middlewareHandler) GetMeasure(message *mixmessages.RoundInfo,
+	auth *connect.Auth) (metrics *mixmessages.RoundMetrics, err error) {
+	err = m.invoke("GetMeasure", message, auth, func() error {
+		metrics, err = m.handler.GetMeasure(message, auth)
+		return err
+	})
+	return metrics, err
+}
+
+func (m *middlewareHandler) PostPhase(message *mixmessages.Batch,
+	auth *connect.Auth) error {
+	return m.invoke("PostPhase", message, auth, func() error {
+		return m.handler.PostPhase(message, auth)
+	})
+}
+
+func (m *middlewareHandler) StreamPostPhase(
+	server mixmessages.Node_StreamPostPhaseServer, auth *connect.Auth) error {
+	return m.invoke("StreamPostPhase", nil, auth, func() error {
+		return m.handler.StreamPostPhase(server, auth)
+	})
+}
+
+func (m *middlewareHandler) PostPrecompResult(roundID uint64, numSlots uint32,
+	auth *connect.Auth) error {
+	return m.invoke("PostPrecompResult", nil, auth, func() error {
+		return m.handler.PostPrecompResult(roundID, numSlots, auth)
+	})
+}
+
+func (m *middlewareHandler) Poll(msg *mixmessages.ServerPoll,
+	auth *connect.Auth) (response *mixmessages.ServerPollResponse, err error) {
+	err = m.invoke("Poll", msg, auth, func() error {
+		response, err = m.handler.Poll(msg, auth)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) SendRoundTripPing(
+	ping *mixmessages.RoundTripPing, auth *connect.Auth) error {
+	return m.invoke("SendRoundTripPing", ping, auth, func() error {
+		return m.handler.SendRoundTripPing(ping, auth)
+	})
+}
+
+func (m *middlewareHandler) AskOnline() error {
+	return m.invoke("AskOnline", nil, nil, m.handler.AskOnline)
+}
+
+func (m *middlewareHandler) RoundError(roundError *mixmessages.RoundError,
+	auth *connect.Auth) error {
+	return m.invoke("RoundError", roundError, auth, func() error {
+		return m.handler.RoundError(roundError, auth)
+	})
+}
+
+func (m *middlewareHandler) GetNDF() (ndf *interconnect.NDF, err error) {
+	err = m.invoke("GetNDF", nil, nil, func() error {
+		ndf, err = m.handler.GetNDF()
+		return err
+	})
+	return ndf, err
+}
+
+func (m *middlewareHandler) GetPermissioningAddress() (address string, err error) {
+	err = m.invoke("GetPermissioningAddress", nil, nil, func() error {
+		address, err = m.handler.GetPermissioningAddress()
+		return err
+	})
+	return address, err
+}
+
+func (m *middlewareHandler) StartSharePhase(ri *mixmessages.RoundInfo,
+	auth *connect.Auth) error {
+	return m.invoke("StartSharePhase", ri, auth, func() error {
+		return m.handler.StartSharePhase(ri, auth)
+	})
+}
+
+func (m *middlewareHandler) SharePhaseRound(
+	sharedPiece *mixmessages.SharePiece, auth *connect.Auth) error {
+	return m.invoke("SharePhaseRound", sharedPiece, auth, func() error {
+		return m.handler.SharePhaseRound(sharedPiece, auth)
+	})
+}
+
+func (m *middlewareHandler) ShareFinalKey(sharedPiece *mixmessages.SharePiece,
+	auth *connect.Auth) error {
+	return m.invoke("ShareFinalKey", sharedPiece, auth, func() error {
+		return m.handler.ShareFinalKey(sharedPiece, auth)
+	})
+}
+
+func (m *middlewareHandler) RequestClientKey(
+	nonceRequest *mixmessages.SignedClientKeyRequest, auth *connect.Auth) (
+	response *mixmessages.SignedKeyResponse, err error) {
+	err = m.invoke("RequestClientKey", nonceRequest, auth, func() error {
+		response, err = m.handler.RequestClientKey(nonceRequest, auth)
+		return err
+	})
+	return response, err
+}
@@ -0,0 +1,54 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package node
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/middleware"
+	"gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"testing"
+	"time"
+)
+
+// Tests that calls to a Handler wrapped with WithMiddleware pass the endpoint
+// name, request and auth state to the hooks, and that errors from the handler
+// reach both the After hook and the caller.
+func TestWithMiddleware(t *testing.T) {
+	handlerErr := errors.New("handler error")
+	impl := NewImplementation()
+	impl.Functions.CreateNewRound = func(
+		*mixmessages.RoundInfo, *connect.Auth) error {
+		return handlerErr
+	}
+
+	var before *middleware.Call
+	var afterErr error
+	handler := WithMiddleware(impl, middleware.NewChain(middleware.Middleware{
+		Before: func(call *middleware.Call) error {
+			before = call
+			return nil
+		},
+		After: func(call *middleware.Call, latency time.Duration, err error) {
+			afterErr = err
+		},
+	}))
+
+	msg := &mixmessages.RoundInfo{ID: 5}
+	auth := &connect.Auth{IsAuthenticated: true}
+	if err := handler.CreateNewRound(msg, auth); err != handlerErr {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if before == nil || before.Endpoint != "CreateNewRound" ||
+		before.Request != msg || before.Auth != auth {
+		t.Errorf("Unexpected call: %+v", before)
+	}
+	if afterErr != handlerErr {
+		t.Errorf("Unexpected error in After hook: %+v", afterErr)
+	}
+}
@@ -0,0 +1,84 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the middleware wrapper for the Notification Bot Handler
+
+package notificationBot
+
+import (
+	"gitlab.com/elixxir/comms/middleware"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+)
+
+// WithMiddleware returns a Handler that calls each method of the handler
+// through the middleware chain. The endpoint name of each call is the name of
+// the Handler method.
+func WithMiddleware(handler Handler, chain middleware.Chain) Handler {
+	return &middlewareHandler{handler: handler, chain: chain}
+}
+
+// middlewareHandler wraps a Handler with a middleware.Chain.
+type middlewareHandler struct {
+	handler Handler
+	chain   middleware.Chain
+}
+
+// invoke calls the handle function for the endpoint through the chain.
+func (m *middlewareHandler) invoke(endpoint string, request interface{},
+	auth *connect.Auth, handle func() error) error {
+	return m.chain.Invoke(&middleware.Call{
+		Endpoint: endpoint, Request: request, Auth: auth}, handle)
+}
+
+func (m *middlewareHandler) RegisterForNotifications(
+	msg *pb.NotificationRegisterRequest) error {
+	return m.invoke("RegisterForNotifications", msg, nil, func() error {
+		return m.handler.RegisterForNotifications(msg)
+	})
+}
+
+func (m *middlewareHandler) UnregisterForNotifications(
+	msg *pb.NotificationUnregisterRequest) error {
+	return m.invoke("UnregisterForNotifications", msg, nil, func() error {
+		return m.handler.UnregisterForNotifications(msg)
+	})
+}
+
+func (m *middlewareHandler) ReceiveNotificationBatch(
+	notifBatch *pb.NotificationBatch, auth *connect.Auth) error {
+	return m.invoke("ReceiveNotificationBatch", notifBatch, auth, func() error {
+		return m.handler.ReceiveNotificationBatch(notifBatch, auth)
+	})
+}
+
+func (m *middlewareHandler) RegisterTrackedID(
+	msg *pb.RegisterTrackedIdRequest) error {
+	return m.invoke("RegisterTrackedID", msg, nil, func() error {
+		return m.handler.RegisterTrackedID(msg)
+	})
+}
+
+func (m *middlewareHandler) UnregisterTrackedID(
+	msg *pb.UnregisterTrackedIdRequest) error {
+	return m.invoke("UnregisterTrackedID", msg, nil, func() error {
+		return m.handler.UnregisterTrackedID(msg)
+	})
+}
+
+func (m *middlewareHandler) RegisterToken(msg *pb.RegisterTokenRequest) error {
+	return m.invoke("RegisterToken", msg, nil, func() error {
+		return m.handler.RegisterToken(msg)
+	})
+}
+
+func (m *middlewareHandler) UnregisterToken(
+	msg *pb.UnregisterTokenRequest) error {
+	return m.invoke("UnregisterToken", msg, nil, func() error {
+		return m.handler.UnregisterToken(msg)
+	})
+}
@@ -0,0 +1,65 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package notificationBot
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/middleware"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"testing"
+	"time"
+)
+
+// Tests that calls to a Handler wrapped with WithMiddleware pass the endpoint
+// name, request and auth state to the hooks, that errors from the handler
+// reach the caller and that a Before hook can reject a call before it reaches
+// the handler.
+func TestWithMiddleware(t *testing.T) {
+	handlerErr := errors.New("handler error")
+	impl := NewImplementation()
+	handled := 0
+	impl.Functions.ReceiveNotificationBatch = func(
+		*pb.NotificationBatch, *connect.Auth) error {
+		handled++
+		return handlerErr
+	}
+
+	var before *middleware.Call
+	var afterErr, rejectErr error
+	handler := WithMiddleware(impl, middleware.NewChain(middleware.Middleware{
+		Before: func(call *middleware.Call) error {
+			before = call
+			return rejectErr
+		},
+		After: func(call *middleware.Call, latency time.Duration, err error) {
+			afterErr = err
+		},
+	}))
+
+	msg := &pb.NotificationBatch{RoundID: 5}
+	auth := &connect.Auth{IsAuthenticated: true}
+	if err := handler.ReceiveNotificationBatch(msg, auth); err != handlerErr {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+	if before == nil || before.Endpoint != "ReceiveNotificationBatch" ||
+		before.Request != msg || before.Auth != auth {
+		t.Errorf("Unexpected call: %+v", before)
+	}
+	if afterErr != handlerErr {
+		t.Errorf("Unexpected error in After hook: %+v", afterErr)
+	}
+
+	rejectErr = errors.New("rejected by middleware")
+	if err := handler.ReceiveNotificationBatch(msg, auth); err != rejectErr {
+		t.Errorf("Expected call to be rejected, received: %+v", err)
+	}
+	if handled != 1 {
+		t.Errorf("Rejected call reached the handler.")
+	}
+}
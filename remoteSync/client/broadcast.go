@@ -1,31 +1,64 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains client -> remote sync server functionality
+
 package client
 
 import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
-	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
 	pb "gitlab.com/elixxir/comms/mixmessages"
 	"gitlab.com/xx_network/comms/connect"
 	"gitlab.com/xx_network/comms/messages"
 )
 
-// Login to the server, receiving an authentication token
+// Login to the server, receiving an authentication token. On success, the
+// token and the credentials used to obtain it are cached for the host so that
+// subsequent requests without a token are authenticated automatically and
+// renewed once the token expires.
 func (rc *Comms) Login(host *connect.Host, msg *pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error) {
+	result, err := rc.sendLogin(host, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	rc.sessions.set(host.GetId(), msg, result)
+	return result, nil
+}
+
+// sendLogin sends the Login message without touching the session cache.
+func (rc *Comms) sendLogin(host *connect.Host, msg *pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error) {
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
-			Login(ctx, msg)
+		var resultMsg = &pb.RsAuthenticationResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/Login", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				Login(ctx, msg)
+		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
+	jww.TRACE.Printf("Sending Login message for user %q", msg.GetUsername())
 	resultMsg, err := rc.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -36,23 +69,40 @@ func (rc *Comms) Login(host *connect.Host, msg *pb.RsAuthenticationRequest) (*pb
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// Read a resource from a RemoteSync server.
+// Read a resource from a RemoteSync server. If the message carries no token,
+// the cached token for the host is used.
 func (rc *Comms) Read(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsReadRequest{Path: msg.GetPath(), Token: token}
+
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
-			Read(ctx, msg)
+		var resultMsg = &pb.RsReadResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/Read", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				Read(ctx, msg)
+		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
+	jww.TRACE.Printf("Sending Read message for path %q", msg.GetPath())
 	resultMsg, err := rc.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -63,23 +113,45 @@ func (rc *Comms) Read(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadResp
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// Write data to a path at a RemoteSync server
+// Write data to a path at a RemoteSync server. If the message carries no
+// token, the cached token for the host is used. If the message has a
+// precondition that does not match the current version of the path, the
+// returned error contains a mixmessages.RsWriteConflictError, which can be
+// retrieved using mixmessages.GetRsWriteConflictError.
 func (rc *Comms) Write(host *connect.Host, msg *pb.RsWriteRequest) (*messages.Ack, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsWriteRequest{Path: msg.GetPath(), Data: msg.GetData(),
+		Token: token, Precondition: msg.GetPrecondition()}
+
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
-			Write(ctx, msg)
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/Write", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				Write(ctx, msg)
+		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
+	jww.TRACE.Printf("Sending Write message for path %q (%d bytes)",
+		msg.GetPath(), len(msg.GetData()))
 	resultMsg, err := rc.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -90,23 +162,41 @@ func (rc *Comms) Write(host *connect.Host, msg *pb.RsWriteRequest) (*messages.Ac
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// GetLastModified returns the last time a path was modified.
+// GetLastModified returns the last time a path was modified. If the message
+// carries no token, the cached token for the host is used.
 func (rc *Comms) GetLastModified(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsTimestampResponse, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsReadRequest{Path: msg.GetPath(), Token: token}
+
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
-			GetLastModified(ctx, msg)
+		var resultMsg = &pb.RsTimestampResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/GetLastModified", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				GetLastModified(ctx, msg)
+		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
+	jww.TRACE.Printf("Sending GetLastModified message for path %q",
+		msg.GetPath())
 	resultMsg, err := rc.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -117,23 +207,40 @@ func (rc *Comms) GetLastModified(host *connect.Host, msg *pb.RsReadRequest) (*pb
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// GetLastWrite returns the last time a remote sync server was modified.
+// GetLastWrite returns the last time a remote sync server was modified. If the
+// message carries no token, the cached token for the host is used.
 func (rc *Comms) GetLastWrite(host *connect.Host, msg *pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsLastWriteRequest{Token: token}
+
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
-			GetLastWrite(ctx, msg)
+		var resultMsg = &pb.RsTimestampResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/GetLastWrite", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				GetLastWrite(ctx, msg)
+		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
+	jww.TRACE.Printf("Sending GetLastWrite message")
 	resultMsg, err := rc.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -144,23 +251,40 @@ func (rc *Comms) GetLastWrite(host *connect.Host, msg *pb.RsLastWriteRequest) (*
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
 
-// ReadDir returns all entries in a given path.
+// ReadDir returns all entries in a given path. If the message carries no
+// token, the cached token for the host is used.
 func (rc *Comms) ReadDir(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadDirResponse, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsReadRequest{Path: msg.GetPath(), Token: token}
+
 	// Create the Send Function
 	f := func(conn connect.Connection) (*any.Any, error) {
 		// Set up the context
 		ctx, cancel := host.GetMessagingContext()
 		defer cancel()
+
 		// Send the message
-		resultMsg, err := pb.NewRemoteSyncClient(conn.GetGrpcConn()).
-			ReadDir(ctx, msg)
+		var resultMsg = &pb.RsReadDirResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/ReadDir", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				ReadDir(ctx, msg)
+		}
 		if err != nil {
-			return nil, errors.New(err.Error())
+			return nil, err
 		}
 		return ptypes.MarshalAny(resultMsg)
 	}
 
 	// Execute the Send function
+	jww.TRACE.Printf("Sending ReadDir message for path %q", msg.GetPath())
 	resultMsg, err := rc.Send(host, f)
 	if err != nil {
 		return nil, err
@@ -170,3 +294,189 @@ func (rc *Comms) ReadDir(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadD
 	result := &pb.RsReadDirResponse{}
 	return result, ptypes.UnmarshalAny(resultMsg, result)
 }
+
+// Delete removes a path from a RemoteSync server. If the message carries no
+// token, the cached token for the host is used. Preconditions are handled as in
+// Comms.Write.
+func (rc *Comms) Delete(host *connect.Host, msg *pb.RsDeleteRequest) (*messages.Ack, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsDeleteRequest{Path: msg.GetPath(), Token: token,
+		Precondition: msg.GetPrecondition()}
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/Delete", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				Delete(ctx, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Delete message for path %q", msg.GetPath())
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Stat returns the size, last modified time and version of a path without
+// reading its data. If the message carries no token, the cached token for the
+// host is used.
+func (rc *Comms) Stat(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsReadRequest{Path: msg.GetPath(), Token: token}
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &pb.RsStatResponse{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/Stat", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				Stat(ctx, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Stat message for path %q", msg.GetPath())
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &pb.RsStatResponse{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// Rename moves the data at a path on a RemoteSync server to a new path. If the
+// message carries no token, the cached token for the host is used.
+func (rc *Comms) Rename(host *connect.Host, msg *pb.RsRenameRequest) (*messages.Ack, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsRenameRequest{OldPath: msg.GetOldPath(),
+		NewPath: msg.GetNewPath(), Token: token,
+		Precondition: msg.GetPrecondition()}
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/Rename", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				Rename(ctx, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending Rename message from path %q to %q",
+		msg.GetOldPath(), msg.GetNewPath())
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
+
+// WriteBatch atomically applies a set of writes and deletes on a RemoteSync
+// server. If the message carries no token, the cached token for the host is
+// used. If any precondition fails, none of the operations are applied and the
+// error is handled as in Comms.Write.
+func (rc *Comms) WriteBatch(host *connect.Host, msg *pb.RsWriteBatchRequest) (*messages.Ack, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsWriteBatchRequest{Writes: msg.GetWrites(),
+		Deletes: msg.GetDeletes(), Token: token}
+
+	// Create the Send Function
+	f := func(conn connect.Connection) (*any.Any, error) {
+		// Set up the context
+		ctx, cancel := host.GetMessagingContext()
+		defer cancel()
+
+		// Send the message
+		var resultMsg = &messages.Ack{}
+		var err error
+		if conn.IsWeb() {
+			wc := conn.GetWebConn()
+			err = wc.Invoke(
+				ctx, "/mixmessages.RemoteSync/WriteBatch", msg, resultMsg)
+		} else {
+			resultMsg, err = pb.NewRemoteSyncClient(conn.GetGrpcConn()).
+				WriteBatch(ctx, msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ptypes.MarshalAny(resultMsg)
+	}
+
+	// Execute the Send function
+	jww.TRACE.Printf("Sending WriteBatch message with %d writes and %d deletes",
+		len(msg.GetWrites()), len(msg.GetDeletes()))
+	resultMsg, err := rc.Send(host, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshall the result
+	result := &messages.Ack{}
+	return result, ptypes.UnmarshalAny(resultMsg, result)
+}
@@ -0,0 +1,259 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/remoteSync/server"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	jww.SetStdoutThreshold(jww.LevelTrace)
+	connect.TestingOnlyDisableTLS = true
+	os.Exit(m.Run())
+}
+
+var portLock sync.Mutex
+var port = 6300
+
+// Utility function to avoid address collisions in testing suite
+func getNextAddress() string {
+	portLock.Lock()
+	defer func() {
+		port++
+		portLock.Unlock()
+	}()
+	return fmt.Sprintf("0.0.0.0:%d", port)
+}
+
+// newTestHost creates a new client Comms and a host pointing at the address.
+func newTestHost(t *testing.T, addr string) (*Comms, *connect.Host) {
+	c, err := NewClientComms(id.NewIdFromString("client", id.User, t),
+		nil, nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create client comms: %+v", err)
+	}
+	manager := connect.NewManagerTesting(t)
+
+	params := connect.GetDefaultHostParams()
+	params.AuthEnabled = false
+	host, err := manager.AddHost(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, nil, params)
+	if err != nil {
+		t.Fatalf("Unable to call NewHost: %+v", err)
+	}
+
+	return c, host
+}
+
+// Tests that Comms.Login caches the token and that it is attached to
+// subsequent requests that do not provide their own.
+func TestComms_Login_CachesToken(t *testing.T) {
+	addr := getNextAddress()
+	token := []byte("token")
+	impl := server.NewImplementation()
+	impl.Functions.Login = func(*pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error) {
+		return &pb.RsAuthenticationResponse{Token: token,
+			ExpiresAt: time.Now().Add(time.Hour).UnixNano()}, nil
+	}
+	var received []byte
+	impl.Functions.Read = func(msg *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+		received = msg.GetToken()
+		return &pb.RsReadResponse{Data: []byte(msg.GetPath())}, nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+
+	_, err := c.Login(host, &pb.RsAuthenticationRequest{Username: "user"})
+	if err != nil {
+		t.Fatalf("Login error: %+v", err)
+	}
+
+	resp, err := c.Read(host, &pb.RsReadRequest{Path: "path"})
+	if err != nil {
+		t.Fatalf("Read error: %+v", err)
+	}
+	if string(resp.GetData()) != "path" {
+		t.Errorf("Unexpected data.\nexpected: %q\nreceived: %q",
+			"path", resp.GetData())
+	}
+	if !bytes.Equal(received, token) {
+		t.Errorf("Server did not receive the cached token."+
+			"\nexpected: %q\nreceived: %q", token, received)
+	}
+
+	cached, _, exists := c.GetToken(host.GetId())
+	if !exists || !bytes.Equal(cached, token) {
+		t.Errorf("Unexpected cached token.\nexpected: %q\nreceived: %q",
+			token, cached)
+	}
+}
+
+// Tests that an expired token causes Comms to log in again with the cached
+// credentials before sending the request.
+func TestComms_getToken_Relogin(t *testing.T) {
+	addr := getNextAddress()
+	var logins int
+	impl := server.NewImplementation()
+	impl.Functions.Login = func(msg *pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error) {
+		logins++
+		return &pb.RsAuthenticationResponse{
+			Token:     []byte(fmt.Sprintf("%s%d", msg.GetUsername(), logins)),
+			ExpiresAt: time.Now().UnixNano(),
+		}, nil
+	}
+	var received []byte
+	impl.Functions.GetLastWrite = func(msg *pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error) {
+		received = msg.GetToken()
+		return &pb.RsTimestampResponse{}, nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+
+	_, err := c.Login(host, &pb.RsAuthenticationRequest{Username: "user"})
+	if err != nil {
+		t.Fatalf("Login error: %+v", err)
+	}
+
+	_, err = c.GetLastWrite(host, &pb.RsLastWriteRequest{})
+	if err != nil {
+		t.Fatalf("GetLastWrite error: %+v", err)
+	}
+
+	if logins != 2 {
+		t.Errorf("Unexpected number of logins.\nexpected: %d\nreceived: %d",
+			2, logins)
+	}
+	if string(received) != "user2" {
+		t.Errorf("Server did not receive the renewed token."+
+			"\nexpected: %q\nreceived: %q", "user2", received)
+	}
+}
+
+// Error path: Tests that Comms.Write returns an error when no token is
+// provided and Login has not been called for the host.
+func TestComms_Write_NoSessionError(t *testing.T) {
+	c, host := newTestHost(t, getNextAddress())
+
+	_, err := c.Write(host, &pb.RsWriteRequest{Path: "path"})
+	if err == nil {
+		t.Errorf("Write did not return an error without a session.")
+	}
+}
+
+// Tests that a write with a stale precondition results in an error from which
+// the current version can be recovered.
+func TestComms_Write_Conflict(t *testing.T) {
+	addr := getNextAddress()
+	var version int64
+	impl := server.NewImplementation()
+	impl.Functions.Write = func(msg *pb.RsWriteRequest) (*messages.Ack, error) {
+		if err := msg.CheckPrecondition(version); err != nil {
+			return nil, err
+		}
+		version++
+		return &messages.Ack{}, nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+	msg := &pb.RsWriteRequest{Path: "path", Token: []byte("token"),
+		Precondition: &pb.RsWritePrecondition{ExpectedVersion: 0}}
+
+	if _, err := c.Write(host, msg); err != nil {
+		t.Fatalf("Write error: %+v", err)
+	}
+
+	// The same precondition is now stale
+	_, err := c.Write(host, msg)
+	conflict, ok := pb.GetRsWriteConflictError(err)
+	if !ok {
+		t.Fatalf("Failed to get conflict from error: %+v", err)
+	}
+	if conflict.CurrentVersion != 1 || conflict.Path != "path" {
+		t.Errorf("Unexpected conflict: %+v", conflict)
+	}
+}
+
+// Smoke test of Comms.Delete, Comms.Stat, Comms.Rename and Comms.WriteBatch.
+func TestComms_Delete_Stat_Rename_WriteBatch(t *testing.T) {
+	addr := getNextAddress()
+	impl := server.NewImplementation()
+	var received []string
+	impl.Functions.Delete = func(msg *pb.RsDeleteRequest) (*messages.Ack, error) {
+		received = append(received, "delete "+msg.GetPath())
+		return &messages.Ack{}, nil
+	}
+	impl.Functions.Stat = func(msg *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+		return &pb.RsStatResponse{Size: 5, LastModified: 6, Version: 7}, nil
+	}
+	impl.Functions.Rename = func(msg *pb.RsRenameRequest) (*messages.Ack, error) {
+		received = append(received,
+			"rename "+msg.GetOldPath()+" "+msg.GetNewPath())
+		return &messages.Ack{}, nil
+	}
+	impl.Functions.WriteBatch = func(msg *pb.RsWriteBatchRequest) (*messages.Ack, error) {
+		received = append(received, fmt.Sprintf("batch %d %d %s",
+			len(msg.GetWrites()), len(msg.GetDeletes()), msg.GetToken()))
+		return &messages.Ack{}, nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+	token := []byte("token")
+
+	if _, err := c.Delete(host, &pb.RsDeleteRequest{Path: "a", Token: token}); err != nil {
+		t.Errorf("Delete error: %+v", err)
+	}
+	stat, err := c.Stat(host, &pb.RsReadRequest{Path: "a", Token: token})
+	if err != nil {
+		t.Errorf("Stat error: %+v", err)
+	} else if stat.GetSize() != 5 || stat.GetLastModified() != 6 ||
+		stat.GetVersion() != 7 {
+		t.Errorf("Unexpected stat response: %s", stat)
+	}
+	_, err = c.Rename(host,
+		&pb.RsRenameRequest{OldPath: "a", NewPath: "b", Token: token})
+	if err != nil {
+		t.Errorf("Rename error: %+v", err)
+	}
+	_, err = c.WriteBatch(host, &pb.RsWriteBatchRequest{
+		Writes:  []*pb.RsWriteRequest{{Path: "c"}, {Path: "d"}},
+		Deletes: []*pb.RsDeleteRequest{{Path: "e"}},
+		Token:   token,
+	})
+	if err != nil {
+		t.Errorf("WriteBatch error: %+v", err)
+	}
+
+	expected := []string{"delete a", "rename a b", "batch 2 1 token"}
+	if fmt.Sprint(received) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected operations.\nexpected: %v\nreceived: %v",
+			expected, received)
+	}
+}
@@ -9,6 +9,7 @@ import (
 // Comms is an object used for top-level remote sync client calls.
 type Comms struct {
 	*connect.ProtoComms
+	sessions *sessionMap
 }
 
 // NewClientComms returns a Comms object with given attributes.
@@ -17,5 +18,5 @@ func NewClientComms(id *id.ID, pubKeyPem, privKeyPem, salt []byte) (*Comms, erro
 	if err != nil {
 		return nil, errors.Errorf("Unable to create Client comms: %+v", err)
 	}
-	return &Comms{pc}, nil
+	return &Comms{pc, newSessionMap()}, nil
 }
@@ -0,0 +1,146 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Caches authentication tokens issued by remote sync servers
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/primitives/id"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin is how long before a token's reported expiry it is
+// considered expired, so that a request is not sent with a token that expires
+// while in flight.
+const tokenExpiryMargin = 30 * time.Second
+
+// Error messages.
+const (
+	noSessionErr = "no remote sync session for host %s; Login must be called first"
+	reloginErr   = "failed to renew expired remote sync token for host %s"
+)
+
+// session is the authentication state for a single remote sync server.
+type session struct {
+	credentials *pb.RsAuthenticationRequest
+	token       []byte
+
+	// expiresAt is the time the token expires. The zero value indicates
+	// that the token does not expire.
+	expiresAt time.Time
+
+	mux sync.Mutex
+}
+
+// sessionMap tracks a session for each remote sync server host.
+type sessionMap struct {
+	sessions map[id.ID]*session
+	mux      sync.Mutex
+}
+
+// newSessionMap initialises an empty sessionMap.
+func newSessionMap() *sessionMap {
+	return &sessionMap{sessions: make(map[id.ID]*session)}
+}
+
+// get returns the session for the host ID, if one exists.
+func (sm *sessionMap) get(hostID *id.ID) (*session, bool) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	s, exists := sm.sessions[*hostID]
+	return s, exists
+}
+
+// set stores the token from a successful login along with the credentials
+// used to obtain it, replacing any existing session for the host.
+func (sm *sessionMap) set(hostID *id.ID,
+	credentials *pb.RsAuthenticationRequest, resp *pb.RsAuthenticationResponse) {
+	s := &session{credentials: credentials}
+	s.update(resp)
+
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	sm.sessions[*hostID] = s
+}
+
+// delete removes the session for the host ID.
+func (sm *sessionMap) delete(hostID *id.ID) {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+	delete(sm.sessions, *hostID)
+}
+
+// update sets the token and expiry from the login response. The session's
+// mutex must be held by the caller or the session must not yet be shared.
+func (s *session) update(resp *pb.RsAuthenticationResponse) {
+	s.token = resp.GetToken()
+	if resp.GetExpiresAt() == 0 {
+		s.expiresAt = time.Time{}
+	} else {
+		s.expiresAt = time.Unix(0, resp.GetExpiresAt())
+	}
+}
+
+// expired returns true if the token is expired or will expire within
+// tokenExpiryMargin. The session's mutex must be held by the caller.
+func (s *session) expired(now time.Time) bool {
+	return !s.expiresAt.IsZero() && !now.Add(tokenExpiryMargin).Before(s.expiresAt)
+}
+
+// getToken returns the token to use for a request to the host. If a token is
+// provided, it is returned unchanged. Otherwise, the cached token for the host
+// is returned, logging in again with the cached credentials if it has expired.
+func (rc *Comms) getToken(host *connect.Host, provided []byte) ([]byte, error) {
+	if len(provided) > 0 {
+		return provided, nil
+	}
+
+	s, exists := rc.sessions.get(host.GetId())
+	if !exists {
+		return nil, errors.Errorf(noSessionErr, host.GetId())
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.expired(time.Now()) {
+		jww.DEBUG.Printf("Remote sync token for host %s expired at %s; "+
+			"logging in again", host.GetId(), s.expiresAt)
+		resp, err := rc.sendLogin(host, s.credentials)
+		if err != nil {
+			return nil, errors.WithMessagef(err, reloginErr, host.GetId())
+		}
+		s.update(resp)
+	}
+
+	return s.token, nil
+}
+
+// GetToken returns the cached authentication token for the host and the time
+// it expires. The expiry time is zero if the token does not expire. Returns
+// false if Login has not been called for the host.
+func (rc *Comms) GetToken(hostID *id.ID) ([]byte, time.Time, bool) {
+	s, exists := rc.sessions.get(hostID)
+	if !exists {
+		return nil, time.Time{}, false
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.token, s.expiresAt, true
+}
+
+// Logout discards the cached token and credentials for the host.
+func (rc *Comms) Logout(hostID *id.ID) {
+	rc.sessions.delete(hostID)
+}
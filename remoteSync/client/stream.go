@@ -0,0 +1,150 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains client -> remote sync server streaming functionality for files too
+// large to send in a single message
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc/metadata"
+	"io"
+)
+
+// Error messages.
+const (
+	webStreamErr = "%s is not supported over web connections"
+)
+
+// WriteStream writes data to a path at a RemoteSync server, streaming the
+// request in chunks. If the message carries no token, the cached token for the
+// host is used. Preconditions are handled as in Comms.Write.
+func (rc *Comms) WriteStream(host *connect.Host, msg *pb.RsWriteRequest) (*messages.Ack, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsWriteRequest{Path: msg.GetPath(), Data: msg.GetData(),
+		Token: token, Precondition: msg.GetPrecondition()}
+
+	// Split request into streamable chunks
+	chunks, err := pb.SplitResponseIntoChunks(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create streaming context so you can close stream later and add a
+	// header containing the total number of chunks, their digest and the data
+	// hash
+	ctx, cancel := connect.StreamingContext()
+	defer cancel()
+	md := pb.NewChunkStreamHeader(chunks)
+	md.Set(pb.DataHashHeader, pb.EncodeDataHashHeader(msg.GetData()))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		if conn.IsWeb() {
+			return nil, errors.Errorf(webStreamErr, "WriteStream")
+		}
+		return pb.NewRemoteSyncClient(conn.GetGrpcConn()).WriteStream(ctx)
+	}
+
+	// Execute the Stream function
+	jww.TRACE.Printf("Streaming Write for path %q (%d bytes in %d chunks)",
+		msg.GetPath(), len(msg.GetData()), len(chunks))
+	resultClient, err := rc.Stream(host, f)
+	if err != nil {
+		return nil, err
+	}
+	stream := resultClient.(pb.RemoteSync_WriteStreamClient)
+
+	// Stream each chunk individually
+	for i, chunk := range chunks {
+		if err = stream.Send(chunk); err != nil {
+			if err == io.EOF {
+				// Attempt to read the error returned by the server
+				_, err = stream.CloseAndRecv()
+			}
+			return nil, errors.WithMessagef(err, "Failed to send chunk "+
+				"(%d/%d) for path %q", i, len(chunks), msg.GetPath())
+		}
+	}
+
+	// Receive ack and cancel client streaming context
+	ack, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, errors.WithMessage(err, "Could not receive final "+
+			"acknowledgement on streaming write")
+	}
+
+	return ack, nil
+}
+
+// ReadStream reads a resource from a RemoteSync server, receiving the response
+// in chunks. The hash of the received data is checked against the one sent
+// by the server. If the message carries no token, the cached token for the host
+// is used.
+func (rc *Comms) ReadStream(host *connect.Host, msg *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsReadRequest{Path: msg.GetPath(), Token: token}
+
+	// Create streaming context so you can close stream later and advertise
+	// support for compressed chunks
+	ctx, cancel := connect.StreamingContext()
+	defer cancel()
+	ctx = pb.AdvertiseChunkCompression(ctx)
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		if conn.IsWeb() {
+			return nil, errors.Errorf(webStreamErr, "ReadStream")
+		}
+		return pb.NewRemoteSyncClient(conn.GetGrpcConn()).ReadStream(ctx, msg)
+	}
+
+	// Execute the Stream function
+	jww.TRACE.Printf("Streaming Read for path %q", msg.GetPath())
+	resultClient, err := rc.Stream(host, f)
+	if err != nil {
+		return nil, err
+	}
+	stream := resultClient.(pb.RemoteSync_ReadStreamClient)
+
+	// Get the data hash from the header and receive the chunks
+	md, err := stream.Header()
+	if err != nil {
+		return nil, errors.Errorf("Could not receive streaming header "+
+			"from %s: %s", host.GetId(), err)
+	}
+	hashHeader := md.Get(pb.DataHashHeader)
+	if len(md.Get(pb.ChunkHeader)) == 0 || len(hashHeader) == 0 {
+		return nil, errors.Errorf(pb.NoStreamingHeaderErr, host.GetId())
+	}
+	chunks, err := pb.ReceiveChunks(md, stream.Recv)
+	if err != nil {
+		return nil, err
+	}
+
+	// Assemble and verify the result
+	result := &pb.RsReadResponse{}
+	err = pb.AssembleCompressedChunksIntoResponse(
+		chunks, result, pb.GetChunkCompression(md))
+	if err != nil {
+		return nil, err
+	}
+
+	return result, pb.VerifyDataHashHeader(hashHeader[0], result.GetData())
+}
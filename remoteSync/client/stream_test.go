@@ -0,0 +1,68 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"bytes"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/remoteSync/server"
+	"gitlab.com/xx_network/comms/messages"
+	"gitlab.com/xx_network/primitives/id"
+	"math/rand"
+	"testing"
+)
+
+// Tests that data written with Comms.WriteStream is received intact by the
+// server and can be read back with Comms.ReadStream.
+func TestComms_WriteStream_ReadStream(t *testing.T) {
+	addr := getNextAddress()
+	stored := make(map[string][]byte)
+	impl := server.NewImplementation()
+	impl.Functions.WriteStream = func(msg *pb.RsWriteRequest) (*messages.Ack, error) {
+		stored[msg.GetPath()] = msg.GetData()
+		return &messages.Ack{}, nil
+	}
+	impl.Functions.ReadStream = func(msg *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+		return &pb.RsReadResponse{Data: stored[msg.GetPath()]}, nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+
+	data := make([]byte, 20*pb.ChunkSize+17)
+	rand.New(rand.NewSource(42)).Read(data)
+	token := []byte("token")
+
+	_, err := c.WriteStream(host,
+		&pb.RsWriteRequest{Path: "path", Data: data, Token: token})
+	if err != nil {
+		t.Fatalf("WriteStream error: %+v", err)
+	}
+	if !bytes.Equal(stored["path"], data) {
+		t.Errorf("Server received unexpected data.")
+	}
+
+	resp, err := c.ReadStream(host, &pb.RsReadRequest{Path: "path", Token: token})
+	if err != nil {
+		t.Fatalf("ReadStream error: %+v", err)
+	}
+	if !bytes.Equal(resp.GetData(), data) {
+		t.Errorf("Read unexpected data.")
+	}
+
+	// Reading a missing path results in an empty stream
+	resp, err = c.ReadStream(host, &pb.RsReadRequest{Path: "empty", Token: token})
+	if err != nil {
+		t.Fatalf("ReadStream error for empty path: %+v", err)
+	}
+	if len(resp.GetData()) != 0 {
+		t.Errorf("Read unexpected data for empty path: %v", resp.GetData())
+	}
+}
@@ -0,0 +1,200 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the client side of the remote sync change watch stream
+
+package client
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Watch reconnection parameters.
+const (
+	// watchBufferSize is the size of the channel returned by Comms.Watch.
+	watchBufferSize = 64
+
+	// watchRetryMinDelay is the delay before the first attempt to reopen a
+	// failed watch stream. It doubles on each failed attempt up to
+	// watchRetryMaxDelay.
+	watchRetryMinDelay = 250 * time.Millisecond
+	watchRetryMaxDelay = 30 * time.Second
+)
+
+// Watch opens a change watch on the host for paths starting with the prefix in
+// the message and returns a channel that receives each change event, along
+// with a function that closes the watch. If the message carries no token, the
+// cached token for the host is used.
+//
+// If the stream fails after it has been opened, it is reopened in the
+// background with exponential backoff until the watch is closed. Changes made
+// while the stream is down are not delivered, so callers that need a complete
+// view should resynchronise (e.g. using GetLastWrite) after a gap. The watch
+// stops without reopening the stream if it fails for a reason that retrying
+// cannot fix: there is no session for the host, or the server rejects the
+// token or the request. The channel is closed once the watch is closed or
+// stops, after which the close function returns the error that stopped it.
+func (rc *Comms) Watch(host *connect.Host, msg *pb.RsWatchRequest) (
+	<-chan *pb.RsChangeEvent, func() error, error) {
+	// Create streaming context so the watch can be closed later
+	ctx, cancel := connect.StreamingContext()
+
+	stream, err := rc.openWatch(ctx, host, msg)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+
+	events := make(chan *pb.RsChangeEvent, watchBufferSize)
+	done := make(chan struct{})
+	var watchErr error
+	go func() {
+		defer close(done)
+		watchErr = rc.watch(ctx, host, msg, stream, events)
+	}()
+
+	stop := func() error {
+		cancel()
+		<-done
+		return watchErr
+	}
+
+	return events, stop, nil
+}
+
+// watch forwards events from the stream to the channel, reopening the stream
+// when it fails, until the context is cancelled or the stream fails
+// permanently. Returns the error that stopped the watch, or nil if it was
+// cancelled.
+func (rc *Comms) watch(ctx context.Context, host *connect.Host,
+	msg *pb.RsWatchRequest, stream pb.RemoteSync_WatchClient,
+	events chan<- *pb.RsChangeEvent) error {
+	defer close(events)
+
+	for {
+		// Forward events until the stream fails
+		event, err := stream.Recv()
+		for ; err == nil; event, err = stream.Recv() {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		if rc.isPermanentWatchErr(host, msg, err) {
+			jww.ERROR.Printf("Remote sync watch for prefix %q on host %s "+
+				"failed permanently: %+v", msg.GetPathPrefix(), host.GetId(), err)
+			return err
+		}
+		jww.WARN.Printf("Remote sync watch for prefix %q on host %s "+
+			"failed, reconnecting: %+v", msg.GetPathPrefix(), host.GetId(), err)
+
+		// Reopen the stream with exponential backoff
+		for delay := watchRetryMinDelay; ; {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(delay):
+			}
+
+			stream, err = rc.openWatch(ctx, host, msg)
+			if err == nil {
+				break
+			} else if ctx.Err() != nil {
+				return nil
+			} else if rc.isPermanentWatchErr(host, msg, err) {
+				jww.ERROR.Printf("Failed to reopen remote sync watch for "+
+					"prefix %q on host %s, stopping: %+v",
+					msg.GetPathPrefix(), host.GetId(), err)
+				return err
+			}
+
+			if delay *= 2; delay > watchRetryMaxDelay {
+				delay = watchRetryMaxDelay
+			}
+			jww.WARN.Printf("Failed to reopen remote sync watch for prefix "+
+				"%q on host %s, retrying in %s: %+v",
+				msg.GetPathPrefix(), host.GetId(), delay, err)
+		}
+	}
+}
+
+// isPermanentWatchErr returns true if the watch failed for a reason that
+// reopening it cannot fix: the message has no token and there is no session
+// for the host, or the server rejected the token or the request.
+func (rc *Comms) isPermanentWatchErr(
+	host *connect.Host, msg *pb.RsWatchRequest, err error) bool {
+	if len(msg.GetToken()) == 0 {
+		if _, exists := rc.sessions.get(host.GetId()); !exists {
+			return true
+		}
+	}
+
+	var statusErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	switch statusErr.GRPCStatus().Code() {
+	case codes.Unauthenticated, codes.PermissionDenied, codes.InvalidArgument:
+		return true
+	default:
+		return false
+	}
+}
+
+// openWatch opens a new Watch stream and waits for the server to confirm that
+// the watch has been registered.
+func (rc *Comms) openWatch(ctx context.Context, host *connect.Host,
+	msg *pb.RsWatchRequest) (pb.RemoteSync_WatchClient, error) {
+	token, err := rc.getToken(host, msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	msg = &pb.RsWatchRequest{PathPrefix: msg.GetPathPrefix(), Token: token}
+
+	// Create the Stream Function
+	f := func(conn connect.Connection) (interface{}, error) {
+		if conn.IsWeb() {
+			return nil, errors.Errorf(webStreamErr, "Watch")
+		}
+		return pb.NewRemoteSyncClient(conn.GetGrpcConn()).Watch(ctx, msg)
+	}
+
+	// Execute the Stream function
+	jww.TRACE.Printf("Opening Watch for prefix %q", msg.GetPathPrefix())
+	resultClient, err := rc.Stream(host, f)
+	if err != nil {
+		return nil, err
+	}
+	stream := resultClient.(pb.RemoteSync_WatchClient)
+
+	// The server sends the header once the watch is registered; if it is
+	// missing, the watch was rejected and the stream holds the error
+	md, err := stream.Header()
+	if err == nil && len(md.Get(pb.WatchOpenedHeader)) == 0 {
+		if _, err = stream.Recv(); err == nil {
+			err = errors.Errorf(pb.NoStreamingHeaderErr, host.GetId())
+		}
+	}
+	if err != nil {
+		return nil, errors.WithMessagef(err, "Could not open watch for "+
+			"prefix %q on host %s", msg.GetPathPrefix(), host.GetId())
+	}
+
+	return stream, nil
+}
@@ -0,0 +1,181 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/elixxir/comms/remoteSync/server"
+	"gitlab.com/xx_network/primitives/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Tests that events published by the server for a matching namespace and
+// prefix are received on the channel returned by Comms.Watch, and that other
+// events are filtered out.
+func TestComms_Watch(t *testing.T) {
+	addr := getNextAddress()
+	impl := server.NewImplementation()
+	impl.Functions.Watch = func(msg *pb.RsWatchRequest) (string, error) {
+		return string(msg.GetToken()), nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+
+	events, stop, err := c.Watch(host,
+		&pb.RsWatchRequest{PathPrefix: "dir/", Token: []byte("user")})
+	if err != nil {
+		t.Fatalf("Watch error: %+v", err)
+	}
+
+	rs.PublishChange("otherUser", &pb.RsChangeEvent{Path: "dir/a"})
+	rs.PublishChange("user", &pb.RsChangeEvent{Path: "other/a"})
+	expected := &pb.RsChangeEvent{Path: "dir/a", Timestamp: 5,
+		Kind: pb.RsChangeKind_RS_CHANGE_WRITE}
+	rs.PublishChange("user", expected)
+
+	select {
+	case event := <-events:
+		if event.GetPath() != expected.GetPath() ||
+			event.GetTimestamp() != expected.GetTimestamp() ||
+			event.GetKind() != expected.GetKind() {
+			t.Errorf("Unexpected event.\nexpected: %s\nreceived: %s",
+				expected, event)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Timed out waiting for event.")
+	}
+
+	stop()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Errorf("Received unexpected event after stopping.")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Channel not closed after stopping.")
+	}
+}
+
+// Error path: Tests that Comms.Watch returns an error when the server rejects
+// the watch.
+func TestComms_Watch_RejectedError(t *testing.T) {
+	addr := getNextAddress()
+	impl := server.NewImplementation()
+	impl.Functions.Watch = func(*pb.RsWatchRequest) (string, error) {
+		return "", errors.New("invalid token")
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+
+	_, _, err := c.Watch(host, &pb.RsWatchRequest{Token: []byte("token")})
+	if err == nil {
+		t.Errorf("Watch did not return an error for a rejected watch.")
+	}
+}
+
+// Tests that the watch is reopened automatically after the server closes the
+// stream because the client fell too far behind.
+func TestComms_Watch_Reconnect(t *testing.T) {
+	addr := getNextAddress()
+	impl := server.NewImplementation()
+	impl.Functions.Watch = func(*pb.RsWatchRequest) (string, error) {
+		return "user", nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+
+	events, stop, err := c.Watch(host,
+		&pb.RsWatchRequest{Token: []byte("token")})
+	if err != nil {
+		t.Fatalf("Watch error: %+v", err)
+	}
+	defer stop()
+
+	// Publish events without reading them until the server drops the watcher
+	for i := 0; i < 100000; i++ {
+		rs.PublishChange("user", &pb.RsChangeEvent{Path: "before"})
+	}
+
+	// Publish until the reopened watch receives a new event
+	timeout := time.After(10 * time.Second)
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case event := <-events:
+			if event.GetPath() == "after" {
+				return
+			}
+		case <-ticker.C:
+			rs.PublishChange("user", &pb.RsChangeEvent{Path: "after"})
+		case <-timeout:
+			t.Fatalf("Timed out waiting for watch to reconnect.")
+		}
+	}
+}
+
+// Error path: Tests that the watch stops, rather than reconnecting, when the
+// server rejects the token while reopening the stream, and that the close
+// function returns the error.
+func TestComms_Watch_PermanentError(t *testing.T) {
+	addr := getNextAddress()
+	impl := server.NewImplementation()
+	var opened uint32
+	impl.Functions.Watch = func(*pb.RsWatchRequest) (string, error) {
+		if atomic.AddUint32(&opened, 1) > 1 {
+			return "", status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return "user", nil
+	}
+	rs := server.StartRemoteSync(
+		id.NewIdFromString("remoteSync", id.Generic, t), addr, impl, nil, nil)
+	defer rs.Shutdown()
+
+	c, host := newTestHost(t, addr)
+
+	events, stop, err := c.Watch(host,
+		&pb.RsWatchRequest{Token: []byte("token")})
+	if err != nil {
+		t.Fatalf("Watch error: %+v", err)
+	}
+
+	// Publish events without reading them until the server drops the watcher
+	for i := 0; i < 100000; i++ {
+		rs.PublishChange("user", &pb.RsChangeEvent{Path: "before"})
+	}
+
+	timeout := time.After(10 * time.Second)
+	for open := true; open; {
+		select {
+		case _, open = <-events:
+		case <-timeout:
+			t.Fatalf("Timed out waiting for watch to stop.")
+		}
+	}
+
+	if err = stop(); status.Code(errors.Cause(err)) != codes.Unauthenticated {
+		t.Errorf("Unexpected error from stopped watch: %+v", err)
+	}
+	if n := atomic.LoadUint32(&opened); n != 2 {
+		t.Errorf("Watch opened %d times.", n)
+	}
+}
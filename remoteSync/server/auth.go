@@ -0,0 +1,188 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the user and session tracking used by the reference Store
+
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+// tokenLen is the length, in bytes, of the session tokens issued by Login.
+const tokenLen = 32
+
+// Parameters of the argon2id hash of the password hash sent by clients, which
+// is all that is stored. Clients hash their password with their own salt, so
+// the hash they send is the password to the server and is hashed again with a
+// server-side salt, so that the stored hash cannot be used to log in.
+const (
+	serverSaltLen      = 16
+	passwordHashTime   = 1
+	passwordHashMemory = 64 * 1024
+	passwordHashLanes  = 4
+	passwordHashLen    = 32
+)
+
+// Error messages.
+const (
+	invalidCredentialsErr = "invalid username or password"
+	invalidTokenErr       = "invalid or expired token"
+	tokenGenerationErr    = "failed to generate token"
+	saltGenerationErr     = "failed to generate salt"
+)
+
+// user is a registered user of the Store.
+type user struct {
+	// salt is the client-side salt, which clients send with the password hash
+	salt []byte
+
+	// passwordHash is the hash of the client's password hash with serverSalt
+	serverSalt   []byte
+	passwordHash []byte
+}
+
+// session is a token issued by Login.
+type session struct {
+	username string
+
+	// expiresAt is the time the session expires; the zero time indicates
+	// that it never expires
+	expiresAt time.Time
+}
+
+// authenticator tracks registered users and the sessions issued to them.
+type authenticator struct {
+	users    map[string]*user
+	sessions map[string]*session
+	lifetime time.Duration
+	mux      sync.Mutex
+}
+
+// newAuthenticator creates an authenticator issuing tokens that are valid for
+// the lifetime. A lifetime of 0 issues tokens that never expire.
+func newAuthenticator(lifetime time.Duration) *authenticator {
+	return &authenticator{
+		users:    make(map[string]*user),
+		sessions: make(map[string]*session),
+		lifetime: lifetime,
+	}
+}
+
+// addUser registers the user, replacing any existing user with the same name
+// and revoking their sessions. The password hash is hashed again with a new
+// server-side salt before it is stored.
+func (a *authenticator) addUser(
+	username string, salt, passwordHash []byte) error {
+	serverSalt := make([]byte, serverSaltLen)
+	if _, err := rand.Read(serverSalt); err != nil {
+		return errors.Wrap(err, saltGenerationErr)
+	}
+	u := &user{
+		salt:         append([]byte{}, salt...),
+		serverSalt:   serverSalt,
+		passwordHash: hashPassword(passwordHash, serverSalt),
+	}
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	a.users[username] = u
+	for token, s := range a.sessions {
+		if s.username == username {
+			delete(a.sessions, token)
+		}
+	}
+
+	return nil
+}
+
+// login checks the credentials and issues a new token for the user.
+func (a *authenticator) login(username string, salt, passwordHash []byte,
+	now time.Time) ([]byte, time.Time, error) {
+	// Hash the password without holding the lock, as it is slow by design.
+	// Unknown users are checked against a user with no password, so they take
+	// as long as known ones.
+	a.mux.Lock()
+	u, exists := a.users[username]
+	a.mux.Unlock()
+	if !exists || username == "" {
+		u = &user{}
+	}
+	match := subtle.ConstantTimeCompare(u.passwordHash,
+		hashPassword(passwordHash, u.serverSalt)) == 1
+	if !exists || username == "" || !bytes.Equal(u.salt, salt) || !match {
+		return nil, time.Time{},
+			status.Error(codes.Unauthenticated, invalidCredentialsErr)
+	}
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	// The user may have been replaced while the password was hashed
+	if a.users[username] != u {
+		return nil, time.Time{},
+			status.Error(codes.Unauthenticated, invalidCredentialsErr)
+	}
+
+	token := make([]byte, tokenLen)
+	if _, err := rand.Read(token); err != nil {
+		return nil, time.Time{}, errors.Wrap(err, tokenGenerationErr)
+	}
+
+	var expiresAt time.Time
+	if a.lifetime > 0 {
+		expiresAt = now.Add(a.lifetime)
+	}
+
+	// Remove expired sessions so that they do not accumulate
+	for t, s := range a.sessions {
+		if s.expired(now) {
+			delete(a.sessions, t)
+		}
+	}
+	a.sessions[string(token)] = &session{username: username, expiresAt: expiresAt}
+
+	return token, expiresAt, nil
+}
+
+// authenticate returns the user the token was issued to. Returns an error if
+// the token is unknown or has expired.
+func (a *authenticator) authenticate(token []byte, now time.Time) (string, error) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	s, exists := a.sessions[string(token)]
+	if !exists {
+		return "", status.Error(codes.Unauthenticated, invalidTokenErr)
+	} else if s.expired(now) {
+		delete(a.sessions, string(token))
+		return "", status.Error(codes.Unauthenticated, invalidTokenErr)
+	}
+
+	return s.username, nil
+}
+
+// hashPassword returns the argon2id hash of the client's password hash with
+// the server-side salt.
+func hashPassword(passwordHash, serverSalt []byte) []byte {
+	return argon2.IDKey(passwordHash, serverSalt, passwordHashTime,
+		passwordHashMemory, passwordHashLanes, passwordHashLen)
+}
+
+// expired returns true if the session has expired at the given time.
+func (s *session) expired(now time.Time) bool {
+	return !s.expiresAt.IsZero() && !now.Before(s.expiresAt)
+}
@@ -44,3 +44,23 @@ func (rc *Comms) GetLastWrite(ctx context.Context, message *pb.RsLastWriteReques
 func (rc *Comms) ReadDir(ctx context.Context, message *pb.RsReadRequest) (*pb.RsReadDirResponse, error) {
 	return rc.handler.ReadDir(message)
 }
+
+// Delete removes a path from the server
+func (rc *Comms) Delete(ctx context.Context, message *pb.RsDeleteRequest) (*messages.Ack, error) {
+	return rc.handler.Delete(message)
+}
+
+// Stat returns the metadata of a resource
+func (rc *Comms) Stat(ctx context.Context, message *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	return rc.handler.Stat(message)
+}
+
+// Rename moves a resource to a new path
+func (rc *Comms) Rename(ctx context.Context, message *pb.RsRenameRequest) (*messages.Ack, error) {
+	return rc.handler.Rename(message)
+}
+
+// WriteBatch atomically applies a set of writes and deletes
+func (rc *Comms) WriteBatch(ctx context.Context, message *pb.RsWriteBatchRequest) (*messages.Ack, error) {
+	return rc.handler.WriteBatch(message)
+}
@@ -0,0 +1,168 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the local directory storage backend of Store
+
+package server
+
+import (
+	"encoding/base64"
+	"github.com/pkg/errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// tempFilePattern is the pattern of the temporary files data is written to
+// before being moved into place.
+const tempFilePattern = ".rs-tmp-*"
+
+// fileStorage stores data in a local directory. Each namespace is kept in a
+// subdirectory of the root named after the base64 encoding of the namespace,
+// and the version of each file is its modification time.
+type fileStorage struct {
+	root string
+}
+
+// NewFileStore creates a Store that keeps all data in files below the root
+// directory, creating it if it does not exist. Data persists across restarts;
+// registered users and issued tokens do not. The file system must record
+// modification times with nanosecond precision for versions to be reliable.
+// Tokens issued by Login are valid for the tokenLifetime; a lifetime of 0
+// issues tokens that never expire.
+func NewFileStore(root string, tokenLifetime time.Duration) (*Store, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create root directory %q", root)
+	}
+	return newStore(&fileStorage{root: root}, tokenLifetime), nil
+}
+
+func (fst *fileStorage) read(namespace, path string) ([]byte, int64, error) {
+	f, err := os.Open(fst.path(namespace, path))
+	if os.IsNotExist(err) {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, err
+	} else if info.IsDir() {
+		return nil, 0, nil
+	}
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, info.ModTime().UnixNano(), nil
+}
+
+func (fst *fileStorage) stat(namespace, path string) (int64, int64, error) {
+	info, err := os.Stat(fst.path(namespace, path))
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	} else if err != nil {
+		return 0, 0, err
+	} else if info.IsDir() {
+		return 0, 0, nil
+	}
+	return info.Size(), info.ModTime().UnixNano(), nil
+}
+
+// write writes the data to a temporary file and moves it into place so that
+// readers never see a partial write.
+func (fst *fileStorage) write(
+	namespace, path string, data []byte, version int64) error {
+	fullPath := fst.path(namespace, path)
+	dir := filepath.Dir(fullPath)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, tempFilePattern)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	modTime := time.Unix(0, version)
+	if err = os.Chtimes(f.Name(), modTime, modTime); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), fullPath)
+}
+
+func (fst *fileStorage) remove(namespace, path string) error {
+	return os.Remove(fst.path(namespace, path))
+}
+
+func (fst *fileStorage) readDir(namespace, path string) ([]string, error) {
+	entries, err := os.ReadDir(fst.path(namespace, path))
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if matched, _ := filepath.Match(tempFilePattern, entry.Name()); !matched {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// lastWrite returns the latest modification time of any file in the
+// namespace. Deletions made before the store was created are not reflected.
+func (fst *fileStorage) lastWrite(namespace string) (int64, error) {
+	var lastWrite int64
+	err := filepath.WalkDir(fst.path(namespace, ""),
+		func(_ string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			} else if entry.IsDir() {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			if modTime := info.ModTime().UnixNano(); modTime > lastWrite {
+				lastWrite = modTime
+			}
+			return nil
+		})
+
+	return lastWrite, err
+}
+
+// path returns the location of the path in the namespace on disk.
+func (fst *fileStorage) path(namespace, path string) string {
+	return filepath.Join(fst.root,
+		base64.RawURLEncoding.EncodeToString([]byte(namespace)),
+		filepath.FromSlash(path))
+}
@@ -22,7 +22,8 @@ import (
 // and the endpoint Handler interface.
 type Comms struct {
 	*connect.ProtoComms
-	handler Handler
+	handler  Handler
+	watchers *watchers
 	*pb.UnimplementedRemoteSyncServer
 	*messages.UnimplementedGenericServer
 }
@@ -31,10 +32,48 @@ type Comms struct {
 type Handler interface {
 	Login(*pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error)
 	Read(*pb.RsReadRequest) (*pb.RsReadResponse, error)
+
+	// Write stores the data at the path. If the request has a precondition,
+	// it must be checked against the current version of the path using
+	// RsWriteRequest.CheckPrecondition and the write rejected with the
+	// returned error on a mismatch.
 	Write(*pb.RsWriteRequest) (*messages.Ack, error)
+
 	GetLastModified(*pb.RsReadRequest) (*pb.RsTimestampResponse, error)
 	GetLastWrite(*pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error)
 	ReadDir(*pb.RsReadRequest) (*pb.RsReadDirResponse, error)
+
+	// WriteStream is called with the assembled request once all chunks of
+	// a streamed write have been received and the data hash verified.
+	// Preconditions must be handled as in Write.
+	WriteStream(*pb.RsWriteRequest) (*messages.Ack, error)
+
+	// ReadStream returns the response to be streamed back in chunks.
+	// Responses to Read and ReadStream should include the version of the path
+	// so that clients can use it in write preconditions.
+	ReadStream(*pb.RsReadRequest) (*pb.RsReadResponse, error)
+
+	// Watch authorizes a change watch and returns the namespace it covers,
+	// typically the user the token belongs to. The watcher receives events
+	// published with Comms.PublishChange to that namespace.
+	Watch(*pb.RsWatchRequest) (namespace string, err error)
+
+	// Delete removes the path. Preconditions must be handled as in Write,
+	// using RsDeleteRequest.CheckPrecondition.
+	Delete(*pb.RsDeleteRequest) (*messages.Ack, error)
+
+	// Stat returns the size, last modified time and version of the path. A
+	// version of 0 indicates that the path does not exist.
+	Stat(*pb.RsReadRequest) (*pb.RsStatResponse, error)
+
+	// Rename moves the data at the old path to the new path.
+	Rename(*pb.RsRenameRequest) (*messages.Ack, error)
+
+	// WriteBatch must apply either all writes and deletes in the batch or none
+	// of them. RsWriteBatchRequest.CheckPreconditions must be called while
+	// holding whatever lock makes the batch atomic, and the batch rejected
+	// with the returned error if it fails.
+	WriteBatch(*pb.RsWriteBatchRequest) (*messages.Ack, error)
 }
 
 // StartRemoteSync starts a new RemoteSync server on the address:port specified by localServer
@@ -51,6 +90,7 @@ func StartRemoteSync(id *id.ID, localServer string, handler Handler,
 	}
 	rsServer := Comms{
 		handler:    handler,
+		watchers:   newWatchers(),
 		ProtoComms: pc,
 	}
 
@@ -69,8 +109,15 @@ type implementationFunctions struct {
 	Read            func(*pb.RsReadRequest) (*pb.RsReadResponse, error)
 	Write           func(*pb.RsWriteRequest) (*messages.Ack, error)
 	GetLastModified func(*pb.RsReadRequest) (*pb.RsTimestampResponse, error)
-	GetLastWrite    func(*messages.Ack) (*pb.RsTimestampResponse, error)
+	GetLastWrite    func(*pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error)
 	ReadDir         func(*pb.RsReadRequest) (*pb.RsReadDirResponse, error)
+	WriteStream     func(*pb.RsWriteRequest) (*messages.Ack, error)
+	ReadStream      func(*pb.RsReadRequest) (*pb.RsReadResponse, error)
+	Watch           func(*pb.RsWatchRequest) (string, error)
+	Delete          func(*pb.RsDeleteRequest) (*messages.Ack, error)
+	Stat            func(*pb.RsReadRequest) (*pb.RsStatResponse, error)
+	Rename          func(*pb.RsRenameRequest) (*messages.Ack, error)
+	WriteBatch      func(*pb.RsWriteBatchRequest) (*messages.Ack, error)
 }
 
 // Implementation allows users of the client library to set the
@@ -104,7 +151,7 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return new(pb.RsTimestampResponse), nil
 			},
-			GetLastWrite: func(*messages.Ack) (*pb.RsTimestampResponse, error) {
+			GetLastWrite: func(*pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error) {
 				warn(um)
 				return new(pb.RsTimestampResponse), nil
 			},
@@ -112,6 +159,34 @@ func NewImplementation() *Implementation {
 				warn(um)
 				return new(pb.RsReadDirResponse), nil
 			},
+			WriteStream: func(*pb.RsWriteRequest) (*messages.Ack, error) {
+				warn(um)
+				return new(messages.Ack), nil
+			},
+			ReadStream: func(*pb.RsReadRequest) (*pb.RsReadResponse, error) {
+				warn(um)
+				return new(pb.RsReadResponse), nil
+			},
+			Watch: func(*pb.RsWatchRequest) (string, error) {
+				warn(um)
+				return "", nil
+			},
+			Delete: func(*pb.RsDeleteRequest) (*messages.Ack, error) {
+				warn(um)
+				return new(messages.Ack), nil
+			},
+			Stat: func(*pb.RsReadRequest) (*pb.RsStatResponse, error) {
+				warn(um)
+				return new(pb.RsStatResponse), nil
+			},
+			Rename: func(*pb.RsRenameRequest) (*messages.Ack, error) {
+				warn(um)
+				return new(messages.Ack), nil
+			},
+			WriteBatch: func(*pb.RsWriteBatchRequest) (*messages.Ack, error) {
+				warn(um)
+				return new(messages.Ack), nil
+			},
 		},
 	}
 }
@@ -129,9 +204,30 @@ func (s *Implementation) Write(message *pb.RsWriteRequest) (*messages.Ack, error
 func (s *Implementation) GetLastModified(message *pb.RsReadRequest) (*pb.RsTimestampResponse, error) {
 	return s.Functions.GetLastModified(message)
 }
-func (s *Implementation) GetLastWrite(message *messages.Ack) (*pb.RsTimestampResponse, error) {
+func (s *Implementation) GetLastWrite(message *pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error) {
 	return s.Functions.GetLastWrite(message)
 }
 func (s *Implementation) ReadDir(message *pb.RsReadRequest) (*pb.RsReadDirResponse, error) {
 	return s.Functions.ReadDir(message)
 }
+func (s *Implementation) WriteStream(message *pb.RsWriteRequest) (*messages.Ack, error) {
+	return s.Functions.WriteStream(message)
+}
+func (s *Implementation) ReadStream(message *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+	return s.Functions.ReadStream(message)
+}
+func (s *Implementation) Watch(message *pb.RsWatchRequest) (string, error) {
+	return s.Functions.Watch(message)
+}
+func (s *Implementation) Delete(message *pb.RsDeleteRequest) (*messages.Ack, error) {
+	return s.Functions.Delete(message)
+}
+func (s *Implementation) Stat(message *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	return s.Functions.Stat(message)
+}
+func (s *Implementation) Rename(message *pb.RsRenameRequest) (*messages.Ack, error) {
+	return s.Functions.Rename(message)
+}
+func (s *Implementation) WriteBatch(message *pb.RsWriteBatchRequest) (*messages.Ack, error) {
+	return s.Functions.WriteBatch(message)
+}
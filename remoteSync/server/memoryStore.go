@@ -0,0 +1,125 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the in-memory storage backend of Store
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Error messages.
+const (
+	pathIsDirErr    = "cannot write %q: it is a directory"
+	parentIsFileErr = "cannot write %q: %q is a file"
+)
+
+// memoryFile is the data stored at a path in memoryStorage.
+type memoryFile struct {
+	data    []byte
+	version int64
+}
+
+// memoryStorage stores data in memory. It is lost when the process exits.
+type memoryStorage struct {
+	namespaces map[string]map[string]*memoryFile
+}
+
+// NewMemoryStore creates a Store that keeps all data in memory. Tokens issued
+// by Login are valid for the tokenLifetime; a lifetime of 0 issues tokens that
+// never expire.
+func NewMemoryStore(tokenLifetime time.Duration) *Store {
+	return newStore(&memoryStorage{
+		namespaces: make(map[string]map[string]*memoryFile),
+	}, tokenLifetime)
+}
+
+func (ms *memoryStorage) read(namespace, path string) ([]byte, int64, error) {
+	f, exists := ms.namespaces[namespace][path]
+	if !exists {
+		return nil, 0, nil
+	}
+	return append([]byte{}, f.data...), f.version, nil
+}
+
+func (ms *memoryStorage) stat(namespace, path string) (int64, int64, error) {
+	f, exists := ms.namespaces[namespace][path]
+	if !exists {
+		return 0, 0, nil
+	}
+	return int64(len(f.data)), f.version, nil
+}
+
+// write rejects a path that is the directory of existing files, or that has an
+// existing file as a parent, in the same manner as the file storage.
+func (ms *memoryStorage) write(
+	namespace, path string, data []byte, version int64) error {
+	files, exists := ms.namespaces[namespace]
+	for i := range path {
+		if path[i] != '/' {
+			continue
+		} else if _, isFile := files[path[:i]]; isFile {
+			return errors.Errorf(parentIsFileErr, path, path[:i])
+		}
+	}
+	if _, isFile := files[path]; !isFile {
+		for p := range files {
+			if strings.HasPrefix(p, path+"/") {
+				return errors.Errorf(pathIsDirErr, path)
+			}
+		}
+	}
+
+	if !exists {
+		files = make(map[string]*memoryFile)
+		ms.namespaces[namespace] = files
+	}
+	files[path] = &memoryFile{data: append([]byte{}, data...), version: version}
+	return nil
+}
+
+func (ms *memoryStorage) remove(namespace, path string) error {
+	delete(ms.namespaces[namespace], path)
+	return nil
+}
+
+func (ms *memoryStorage) readDir(namespace, path string) ([]string, error) {
+	prefix := path
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	// Collect the first element of every path below the directory
+	entries := make(map[string]struct{})
+	for p := range ms.namespaces[namespace] {
+		if !strings.HasPrefix(p, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(p, prefix)
+		if i := strings.IndexByte(name, '/'); i >= 0 {
+			name = name[:i]
+		}
+		entries[name] = struct{}{}
+	}
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// lastWrite always returns 0, as the namespace is empty when the store is
+// created and the Store tracks all later changes.
+func (ms *memoryStorage) lastWrite(string) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,538 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains Store, a reference implementation of the remote sync Handler
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Error messages.
+const (
+	invalidPathErr    = "invalid path %q"
+	pathNotFoundErr   = "path %q does not exist"
+	renameSamePathErr = "cannot rename %q to itself"
+	batchRollbackErr  = "failed to roll back write batch at path %q: %+v"
+)
+
+// storage is the backend of a Store. Data is kept per namespace, which is the
+// name of the user owning it. Paths are cleaned before being passed in. The
+// version of a path is its last modified time in Unix nanoseconds, with 0
+// indicating that the path does not exist. Storage is only accessed while
+// holding the Store lock.
+type storage interface {
+	// read returns the data and version of the path, or a nil slice and a
+	// version of 0 if the path does not exist.
+	read(namespace, path string) (data []byte, version int64, err error)
+
+	// stat returns the size and version of the path, or zeros if the path
+	// does not exist.
+	stat(namespace, path string) (size, version int64, err error)
+
+	// write stores the data at the path with the given version.
+	write(namespace, path string, data []byte, version int64) error
+
+	// remove deletes the path.
+	remove(namespace, path string) error
+
+	// readDir returns the sorted names of the entries directly below the
+	// path. An empty path is the root of the namespace.
+	readDir(namespace, path string) ([]string, error)
+
+	// lastWrite returns the most recent version of any path in the
+	// namespace. It is only called once per namespace, after which the Store
+	// tracks the last write itself.
+	lastWrite(namespace string) (int64, error)
+}
+
+// Store is a reference implementation of Handler. Users are registered with
+// Store.AddUser and each user's data is kept in a separate namespace. It is
+// intended for use as a test fixture and as a minimal self-hosted remote sync
+// server; use NewMemoryStore or NewFileStore to create one.
+type Store struct {
+	auth    *authenticator
+	storage storage
+
+	// lastWrites caches the time of the last modification in each namespace
+	lastWrites map[string]int64
+
+	publish func(namespace string, event *pb.RsChangeEvent)
+
+	// now returns the current time; replaced in tests
+	now func() time.Time
+
+	mux sync.Mutex
+}
+
+// newStore creates a Store on top of the storage.
+func newStore(s storage, tokenLifetime time.Duration) *Store {
+	return &Store{
+		auth:       newAuthenticator(tokenLifetime),
+		storage:    s,
+		lastWrites: make(map[string]int64),
+		publish:    func(string, *pb.RsChangeEvent) {},
+		now:        time.Now,
+	}
+}
+
+// AddUser registers a user that can log in with the salt and the salted
+// password hash, as produced by mixmessages.HashRemoteSyncPassword. Adding an
+// existing user replaces their credentials and revokes their tokens but keeps
+// their data. Only a hash of the password hash is stored.
+func (s *Store) AddUser(username string, salt, passwordHash []byte) error {
+	return s.auth.addUser(username, salt, passwordHash)
+}
+
+// SetChangePublisher sets the function called with every change made to the
+// store, typically Comms.PublishChange of the server the store is used by.
+// The namespace passed to it matches the one returned by Store.Watch.
+func (s *Store) SetChangePublisher(
+	publish func(namespace string, event *pb.RsChangeEvent)) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.publish = publish
+}
+
+// Login issues a token for the user if the salt and password hash match the
+// ones registered with Store.AddUser.
+func (s *Store) Login(msg *pb.RsAuthenticationRequest) (*pb.RsAuthenticationResponse, error) {
+	token, expiresAt, err := s.auth.login(
+		msg.GetUsername(), msg.GetSalt(), msg.GetPasswordHash(), s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.RsAuthenticationResponse{Token: token}
+	if !expiresAt.IsZero() {
+		resp.ExpiresAt = expiresAt.UnixNano()
+	}
+	return resp, nil
+}
+
+// Read returns the data and version stored at the path.
+func (s *Store) Read(msg *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+	namespace, p, err := s.authenticatePath(msg.GetToken(), msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	data, version, err := s.storage.read(namespace, p)
+	if err != nil {
+		return nil, err
+	} else if version == 0 {
+		return nil, status.Errorf(codes.NotFound, pathNotFoundErr, p)
+	}
+
+	return &pb.RsReadResponse{Data: data, Version: version}, nil
+}
+
+// Write stores the data at the path, checking the precondition if one is set.
+func (s *Store) Write(msg *pb.RsWriteRequest) (*messages.Ack, error) {
+	namespace, p, err := s.authenticatePath(msg.GetToken(), msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, version, err := s.storage.stat(namespace, p)
+	if err != nil {
+		return nil, err
+	}
+	if err = msg.CheckPrecondition(version); err != nil {
+		return nil, err
+	}
+
+	version = s.nextVersion(version)
+	if err = s.storage.write(namespace, p, msg.GetData(), version); err != nil {
+		return nil, err
+	}
+
+	s.changed(namespace, &pb.RsChangeEvent{
+		Path: p, Timestamp: version, Kind: pb.RsChangeKind_RS_CHANGE_WRITE})
+	return &messages.Ack{}, nil
+}
+
+// GetLastModified returns the time the path was last modified.
+func (s *Store) GetLastModified(msg *pb.RsReadRequest) (*pb.RsTimestampResponse, error) {
+	namespace, p, err := s.authenticatePath(msg.GetToken(), msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, version, err := s.storage.stat(namespace, p)
+	if err != nil {
+		return nil, err
+	} else if version == 0 {
+		return nil, status.Errorf(codes.NotFound, pathNotFoundErr, p)
+	}
+
+	return &pb.RsTimestampResponse{Timestamp: version}, nil
+}
+
+// GetLastWrite returns the time of the last change made in the user's
+// namespace.
+func (s *Store) GetLastWrite(msg *pb.RsLastWriteRequest) (*pb.RsTimestampResponse, error) {
+	namespace, err := s.auth.authenticate(msg.GetToken(), s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	lastWrite, err := s.lastWrite(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RsTimestampResponse{Timestamp: lastWrite}, nil
+}
+
+// ReadDir returns the names of the entries directly below the path. An empty
+// path lists the root of the user's namespace.
+func (s *Store) ReadDir(msg *pb.RsReadRequest) (*pb.RsReadDirResponse, error) {
+	namespace, err := s.auth.authenticate(msg.GetToken(), s.now())
+	if err != nil {
+		return nil, err
+	}
+	p := cleanPath(msg.GetPath())
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	names, err := s.storage.readDir(namespace, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RsReadDirResponse{Data: names}, nil
+}
+
+// WriteStream stores the data of a streamed write. It behaves the same as
+// Store.Write.
+func (s *Store) WriteStream(msg *pb.RsWriteRequest) (*messages.Ack, error) {
+	return s.Write(msg)
+}
+
+// ReadStream returns the data to be streamed back. It behaves the same as
+// Store.Read.
+func (s *Store) ReadStream(msg *pb.RsReadRequest) (*pb.RsReadResponse, error) {
+	return s.Read(msg)
+}
+
+// Watch authorizes a change watch over the user's namespace.
+func (s *Store) Watch(msg *pb.RsWatchRequest) (string, error) {
+	return s.auth.authenticate(msg.GetToken(), s.now())
+}
+
+// Delete removes the path, checking the precondition if one is set.
+func (s *Store) Delete(msg *pb.RsDeleteRequest) (*messages.Ack, error) {
+	namespace, p, err := s.authenticatePath(msg.GetToken(), msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	_, version, err := s.storage.stat(namespace, p)
+	if err != nil {
+		return nil, err
+	}
+	if err = msg.CheckPrecondition(version); err != nil {
+		return nil, err
+	} else if version == 0 {
+		return nil, status.Errorf(codes.NotFound, pathNotFoundErr, p)
+	}
+
+	if err = s.storage.remove(namespace, p); err != nil {
+		return nil, err
+	}
+
+	s.changed(namespace, &pb.RsChangeEvent{Path: p,
+		Timestamp: s.nextVersion(0), Kind: pb.RsChangeKind_RS_CHANGE_DELETE})
+	return &messages.Ack{}, nil
+}
+
+// Stat returns the size, last modified time and version of the path.
+func (s *Store) Stat(msg *pb.RsReadRequest) (*pb.RsStatResponse, error) {
+	namespace, p, err := s.authenticatePath(msg.GetToken(), msg.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	size, version, err := s.storage.stat(namespace, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RsStatResponse{
+		Size: size, LastModified: version, Version: version}, nil
+}
+
+// Rename moves the data at the old path to the new path, replacing any data
+// already at the new path. The precondition, if one is set, is checked against
+// the old path. If the old path cannot be removed, the new path is restored.
+func (s *Store) Rename(msg *pb.RsRenameRequest) (*messages.Ack, error) {
+	namespace, oldPath, err := s.authenticatePath(msg.GetToken(), msg.GetOldPath())
+	if err != nil {
+		return nil, err
+	}
+	newPath := cleanPath(msg.GetNewPath())
+	if newPath == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument, invalidPathErr, msg.GetNewPath())
+	} else if newPath == oldPath {
+		return nil, status.Errorf(
+			codes.InvalidArgument, renameSamePathErr, oldPath)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	data, oldVersion, err := s.storage.read(namespace, oldPath)
+	if err != nil {
+		return nil, err
+	}
+	if err = msg.CheckPrecondition(oldVersion); err != nil {
+		return nil, err
+	} else if oldVersion == 0 {
+		return nil, status.Errorf(codes.NotFound, pathNotFoundErr, oldPath)
+	}
+
+	// Apply the rename as a batch so that it is rolled back if either the
+	// write or the delete fails
+	events, err := s.applyBatch(namespace, &pb.RsWriteBatchRequest{
+		Writes:  []*pb.RsWriteRequest{{Path: newPath, Data: data}},
+		Deletes: []*pb.RsDeleteRequest{{Path: oldPath}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.changed(namespace, &pb.RsChangeEvent{Path: newPath,
+		Timestamp: events[0].GetTimestamp(),
+		Kind:      pb.RsChangeKind_RS_CHANGE_RENAME, OldPath: oldPath})
+	return &messages.Ack{}, nil
+}
+
+// WriteBatch applies all writes and deletes in the batch or, if any
+// precondition fails or any operation cannot be applied, none of them.
+func (s *Store) WriteBatch(msg *pb.RsWriteBatchRequest) (*messages.Ack, error) {
+	namespace, err := s.auth.authenticate(msg.GetToken(), s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Clean all paths up front so that duplicates are detected
+	batch := &pb.RsWriteBatchRequest{
+		Writes:  make([]*pb.RsWriteRequest, len(msg.GetWrites())),
+		Deletes: make([]*pb.RsDeleteRequest, len(msg.GetDeletes())),
+	}
+	for i, w := range msg.GetWrites() {
+		p := cleanPath(w.GetPath())
+		if p == "" {
+			return nil, status.Errorf(
+				codes.InvalidArgument, invalidPathErr, w.GetPath())
+		}
+		batch.Writes[i] = &pb.RsWriteRequest{Path: p, Data: w.GetData(),
+			Precondition: w.GetPrecondition()}
+	}
+	for i, d := range msg.GetDeletes() {
+		p := cleanPath(d.GetPath())
+		if p == "" {
+			return nil, status.Errorf(
+				codes.InvalidArgument, invalidPathErr, d.GetPath())
+		}
+		batch.Deletes[i] = &pb.RsDeleteRequest{
+			Path: p, Precondition: d.GetPrecondition()}
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	var statErr error
+	err = batch.CheckPreconditions(func(p string) int64 {
+		_, version, err := s.storage.stat(namespace, p)
+		if err != nil && statErr == nil {
+			statErr = err
+		}
+		return version
+	})
+	if statErr != nil {
+		return nil, statErr
+	} else if err != nil {
+		return nil, err
+	}
+
+	events, err := s.applyBatch(namespace, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		s.changed(namespace, event)
+	}
+	return &messages.Ack{}, nil
+}
+
+// applyBatch applies the batch to storage, restoring the previous state of
+// every modified path if an operation fails. Returns the change events for
+// the batch. Must be called while holding the lock.
+func (s *Store) applyBatch(namespace string, batch *pb.RsWriteBatchRequest) (
+	[]*pb.RsChangeEvent, error) {
+	type previous struct {
+		path    string
+		data    []byte
+		version int64
+	}
+	applied := make([]previous, 0, len(batch.Writes)+len(batch.Deletes))
+	events := make([]*pb.RsChangeEvent, 0, cap(applied))
+
+	rollback := func() {
+		for i := len(applied) - 1; i >= 0; i-- {
+			prev := applied[i]
+			var err error
+			if prev.version == 0 {
+				err = s.storage.remove(namespace, prev.path)
+			} else {
+				err = s.storage.write(
+					namespace, prev.path, prev.data, prev.version)
+			}
+			if err != nil {
+				jww.ERROR.Printf(batchRollbackErr, prev.path, err)
+			}
+		}
+	}
+
+	for _, w := range batch.Writes {
+		data, version, err := s.storage.read(namespace, w.Path)
+		if err != nil {
+			rollback()
+			return nil, err
+		}
+		applied = append(applied, previous{w.Path, data, version})
+
+		version = s.nextVersion(version)
+		if err = s.storage.write(namespace, w.Path, w.Data, version); err != nil {
+			rollback()
+			return nil, err
+		}
+		events = append(events, &pb.RsChangeEvent{Path: w.Path,
+			Timestamp: version, Kind: pb.RsChangeKind_RS_CHANGE_WRITE})
+	}
+
+	for _, d := range batch.Deletes {
+		data, version, err := s.storage.read(namespace, d.Path)
+		if err != nil {
+			rollback()
+			return nil, err
+		} else if version == 0 {
+			rollback()
+			return nil, status.Errorf(codes.NotFound, pathNotFoundErr, d.Path)
+		}
+
+		if err = s.storage.remove(namespace, d.Path); err != nil {
+			rollback()
+			return nil, err
+		}
+		applied = append(applied, previous{d.Path, data, version})
+		events = append(events, &pb.RsChangeEvent{Path: d.Path,
+			Timestamp: s.nextVersion(0), Kind: pb.RsChangeKind_RS_CHANGE_DELETE})
+	}
+
+	return events, nil
+}
+
+// authenticatePath returns the namespace of the user the token belongs to and
+// the cleaned path. Returns an error if the token is invalid or the path is
+// empty.
+func (s *Store) authenticatePath(token []byte, p string) (string, string, error) {
+	namespace, err := s.auth.authenticate(token, s.now())
+	if err != nil {
+		return "", "", err
+	}
+
+	cleaned := cleanPath(p)
+	if cleaned == "" {
+		return "", "", status.Errorf(codes.InvalidArgument, invalidPathErr, p)
+	}
+
+	return namespace, cleaned, nil
+}
+
+// nextVersion returns the version for a path modified now that is currently
+// at the given version. Versions are the modification time in Unix
+// nanoseconds, but always increase even if the clock does not.
+func (s *Store) nextVersion(current int64) int64 {
+	version := s.now().UnixNano()
+	if version <= current {
+		version = current + 1
+	}
+	return version
+}
+
+// changed records the change as the last write to the namespace and publishes
+// it. Must be called while holding the lock.
+func (s *Store) changed(namespace string, event *pb.RsChangeEvent) {
+	lastWrite, err := s.lastWrite(namespace)
+	if err != nil {
+		jww.WARN.Printf("Failed to load last write for namespace %q: %+v",
+			namespace, err)
+	}
+	if event.GetTimestamp() > lastWrite {
+		s.lastWrites[namespace] = event.GetTimestamp()
+	}
+
+	s.publish(namespace, event)
+}
+
+// lastWrite returns the time of the last change made in the namespace,
+// loading it from storage the first time. Must be called while holding the
+// lock.
+func (s *Store) lastWrite(namespace string) (int64, error) {
+	if lastWrite, exists := s.lastWrites[namespace]; exists {
+		return lastWrite, nil
+	}
+
+	lastWrite, err := s.storage.lastWrite(namespace)
+	if err != nil {
+		return 0, errors.WithMessagef(err,
+			"failed to load last write for namespace %q", namespace)
+	}
+	s.lastWrites[namespace] = lastWrite
+	return lastWrite, nil
+}
+
+// cleanPath returns the path in a canonical, slash separated form without
+// leading or trailing slashes, such that it cannot refer to anything outside
+// of the namespace. The root of the namespace is returned as an empty string.
+func cleanPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
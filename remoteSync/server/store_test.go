@@ -0,0 +1,337 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package server
+
+import (
+	"bytes"
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestStores returns a memory and a file store, each with the users
+// "alice" and "bob" registered with the password "password".
+func newTestStores(t *testing.T, tokenLifetime time.Duration) map[string]*Store {
+	fileStore, err := NewFileStore(t.TempDir(), tokenLifetime)
+	if err != nil {
+		t.Fatalf("Failed to create file store: %+v", err)
+	}
+	stores := map[string]*Store{
+		"memory": NewMemoryStore(tokenLifetime),
+		"file":   fileStore,
+	}
+
+	for _, s := range stores {
+		for _, username := range []string{"alice", "bob"} {
+			salt := []byte(username + "Salt")
+			err = s.AddUser(username, salt,
+				pb.HashRemoteSyncPassword([]byte("password"), salt))
+			if err != nil {
+				t.Fatalf("Failed to add user %s: %+v", username, err)
+			}
+		}
+	}
+	return stores
+}
+
+// login logs the user into the store and returns the token.
+func login(t *testing.T, s *Store, username string) []byte {
+	salt := []byte(username + "Salt")
+	resp, err := s.Login(&pb.RsAuthenticationRequest{Username: username,
+		PasswordHash: pb.HashRemoteSyncPassword([]byte("password"), salt),
+		Salt:         salt})
+	if err != nil {
+		t.Fatalf("Failed to log in as %s: %+v", username, err)
+	}
+	return resp.GetToken()
+}
+
+// Tests that Store.Login rejects wrong credentials and that tokens expire
+// after the token lifetime.
+func TestStore_Login(t *testing.T) {
+	for name, s := range newTestStores(t, time.Hour) {
+		salt := []byte("aliceSalt")
+		_, err := s.Login(&pb.RsAuthenticationRequest{Username: "alice",
+			PasswordHash: pb.HashRemoteSyncPassword([]byte("wrong"), salt),
+			Salt:         salt})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: Login with wrong password did not return "+
+				"Unauthenticated: %+v", name, err)
+		}
+
+		now := time.Now()
+		s.now = func() time.Time { return now }
+		token := login(t, s, "alice")
+		if _, err = s.Stat(&pb.RsReadRequest{Path: "a", Token: token}); err != nil {
+			t.Errorf("%s: Request with valid token failed: %+v", name, err)
+		}
+
+		s.now = func() time.Time { return now.Add(time.Hour) }
+		_, err = s.Stat(&pb.RsReadRequest{Path: "a", Token: token})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("%s: Request with expired token did not return "+
+				"Unauthenticated: %+v", name, err)
+		}
+	}
+}
+
+// Tests reading, writing, listing, renaming and deleting data, including
+// version preconditions and separation of user namespaces.
+func TestStore_ReadWrite(t *testing.T) {
+	for name, s := range newTestStores(t, 0) {
+		alice, bob := login(t, s, "alice"), login(t, s, "bob")
+
+		_, err := s.Write(&pb.RsWriteRequest{Path: "/dir/a", Data: []byte("1"),
+			Token: alice, Precondition: &pb.RsWritePrecondition{}})
+		if err != nil {
+			t.Fatalf("%s: Write error: %+v", name, err)
+		}
+		read, err := s.Read(&pb.RsReadRequest{Path: "dir/a", Token: alice})
+		if err != nil || string(read.GetData()) != "1" || read.GetVersion() == 0 {
+			t.Fatalf("%s: Unexpected read %v: %+v", name, read, err)
+		}
+
+		// A stale precondition is rejected
+		_, err = s.Write(&pb.RsWriteRequest{Path: "dir/a", Data: []byte("2"),
+			Token: alice, Precondition: &pb.RsWritePrecondition{}})
+		if conflict, ok := pb.GetRsWriteConflictError(err); !ok ||
+			conflict.CurrentVersion != read.GetVersion() {
+			t.Errorf("%s: Unexpected conflict %+v: %+v", name, conflict, err)
+		}
+
+		// Data is not visible to other users
+		_, err = s.Read(&pb.RsReadRequest{Path: "dir/a", Token: bob})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("%s: Read of other user's data did not return "+
+				"NotFound: %+v", name, err)
+		}
+
+		_, err = s.Rename(&pb.RsRenameRequest{
+			OldPath: "dir/a", NewPath: "dir/sub/b", Token: alice})
+		if err != nil {
+			t.Fatalf("%s: Rename error: %+v", name, err)
+		}
+		dir, err := s.ReadDir(&pb.RsReadRequest{Path: "dir", Token: alice})
+		if err != nil || !reflect.DeepEqual(dir.GetData(), []string{"sub"}) {
+			t.Errorf("%s: Unexpected directory %v: %+v", name, dir, err)
+		}
+		stat, err := s.Stat(&pb.RsReadRequest{Path: "dir/sub/b", Token: alice})
+		if err != nil || stat.GetSize() != 1 ||
+			stat.GetVersion() <= read.GetVersion() {
+			t.Errorf("%s: Unexpected stat %v: %+v", name, stat, err)
+		}
+
+		_, err = s.Delete(&pb.RsDeleteRequest{Path: "dir/sub/b", Token: alice,
+			Precondition: &pb.RsWritePrecondition{
+				ExpectedVersion: stat.GetVersion()}})
+		if err != nil {
+			t.Errorf("%s: Delete error: %+v", name, err)
+		}
+		stat, err = s.Stat(&pb.RsReadRequest{Path: "dir/sub/b", Token: alice})
+		if err != nil || stat.GetVersion() != 0 {
+			t.Errorf("%s: Deleted path still exists %v: %+v", name, stat, err)
+		}
+	}
+}
+
+// Tests that Store.WriteBatch applies no operation when one of them fails and
+// that Store.GetLastWrite and the change publisher reflect applied batches.
+func TestStore_WriteBatch(t *testing.T) {
+	for name, s := range newTestStores(t, 0) {
+		token := login(t, s, "alice")
+		var events []*pb.RsChangeEvent
+		s.SetChangePublisher(func(namespace string, event *pb.RsChangeEvent) {
+			if namespace != "alice" {
+				t.Errorf("%s: Unexpected namespace %q", name, namespace)
+			}
+			events = append(events, event)
+		})
+
+		_, err := s.Write(&pb.RsWriteRequest{
+			Path: "a", Data: []byte("old"), Token: token})
+		if err != nil {
+			t.Fatalf("%s: Write error: %+v", name, err)
+		}
+
+		// The delete of a missing path fails after the writes are applied
+		_, err = s.WriteBatch(&pb.RsWriteBatchRequest{
+			Writes: []*pb.RsWriteRequest{
+				{Path: "a", Data: []byte("new")}, {Path: "b", Data: []byte("b")}},
+			Deletes: []*pb.RsDeleteRequest{{Path: "missing"}},
+			Token:   token,
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("%s: Unexpected batch error: %+v", name, err)
+		}
+		read, err := s.Read(&pb.RsReadRequest{Path: "a", Token: token})
+		if err != nil || !bytes.Equal(read.GetData(), []byte("old")) {
+			t.Errorf("%s: Failed batch was not rolled back: %v", name, read)
+		}
+		if stat, _ := s.Stat(&pb.RsReadRequest{Path: "b", Token: token}); stat.GetVersion() != 0 {
+			t.Errorf("%s: Failed batch was not rolled back: %v", name, stat)
+		}
+
+		_, err = s.WriteBatch(&pb.RsWriteBatchRequest{
+			Writes:  []*pb.RsWriteRequest{{Path: "b", Data: []byte("b")}},
+			Deletes: []*pb.RsDeleteRequest{{Path: "a"}},
+			Token:   token,
+		})
+		if err != nil {
+			t.Fatalf("%s: WriteBatch error: %+v", name, err)
+		}
+
+		if len(events) != 3 {
+			t.Fatalf("%s: Expected 3 events, received %d", name, len(events))
+		}
+		lastWrite, err := s.GetLastWrite(&pb.RsLastWriteRequest{Token: token})
+		if err != nil || lastWrite.GetTimestamp() != events[2].GetTimestamp() {
+			t.Errorf("%s: Unexpected last write %v: %+v", name, lastWrite, err)
+		}
+	}
+}
+
+// failingRemoveStorage is a storage whose remove always fails.
+type failingRemoveStorage struct {
+	storage
+}
+
+func (failingRemoveStorage) remove(string, string) error {
+	return errors.New("remove failed")
+}
+
+// Tests that Store.Rename checks the precondition on the old path and restores
+// the new path if the old path cannot be removed.
+func TestStore_Rename(t *testing.T) {
+	for name, s := range newTestStores(t, 0) {
+		token := login(t, s, "alice")
+		for _, p := range []string{"a", "b"} {
+			_, err := s.Write(&pb.RsWriteRequest{
+				Path: p, Data: []byte(p), Token: token})
+			if err != nil {
+				t.Fatalf("%s: Write error: %+v", name, err)
+			}
+		}
+		before, err := s.Stat(&pb.RsReadRequest{Path: "b", Token: token})
+		if err != nil {
+			t.Fatalf("%s: Stat error: %+v", name, err)
+		}
+
+		_, err = s.Rename(&pb.RsRenameRequest{OldPath: "a", NewPath: "b",
+			Token: token, Precondition: &pb.RsWritePrecondition{}})
+		if _, ok := pb.GetRsWriteConflictError(err); !ok {
+			t.Errorf("%s: Expected conflict, received: %+v", name, err)
+		}
+
+		s.storage = failingRemoveStorage{s.storage}
+		_, err = s.Rename(
+			&pb.RsRenameRequest{OldPath: "a", NewPath: "b", Token: token})
+		if err == nil {
+			t.Errorf("%s: Rename succeeded when remove failed", name)
+		}
+		for _, p := range []string{"a", "b"} {
+			read, err := s.Read(&pb.RsReadRequest{Path: p, Token: token})
+			if err != nil || string(read.GetData()) != p {
+				t.Errorf("%s: Failed rename modified %q: %v", name, p, read)
+			}
+		}
+		after, err := s.Stat(&pb.RsReadRequest{Path: "b", Token: token})
+		if err != nil || after.GetVersion() != before.GetVersion() {
+			t.Errorf("%s: Version of new path not restored.\nexpected: %d"+
+				"\nreceived: %d", name, before.GetVersion(), after.GetVersion())
+		}
+	}
+}
+
+// Tests that a new file store loads the last write time from disk.
+func TestNewFileStore_LastWrite(t *testing.T) {
+	root := t.TempDir()
+	s, err := NewFileStore(root, 0)
+	if err != nil {
+		t.Fatalf("Failed to create file store: %+v", err)
+	}
+	err = s.AddUser("alice", []byte("aliceSalt"),
+		pb.HashRemoteSyncPassword([]byte("password"), []byte("aliceSalt")))
+	if err != nil {
+		t.Fatalf("Failed to add user: %+v", err)
+	}
+	token := login(t, s, "alice")
+	if _, err = s.Write(&pb.RsWriteRequest{Path: "a", Token: token}); err != nil {
+		t.Fatalf("Write error: %+v", err)
+	}
+	expected, _ := s.GetLastWrite(&pb.RsLastWriteRequest{Token: token})
+
+	s, err = NewFileStore(root, 0)
+	if err != nil {
+		t.Fatalf("Failed to create file store: %+v", err)
+	}
+	err = s.AddUser("alice", []byte("aliceSalt"),
+		pb.HashRemoteSyncPassword([]byte("password"), []byte("aliceSalt")))
+	if err != nil {
+		t.Fatalf("Failed to add user: %+v", err)
+	}
+	token = login(t, s, "alice")
+	received, err := s.GetLastWrite(&pb.RsLastWriteRequest{Token: token})
+	if err != nil || received.GetTimestamp() != expected.GetTimestamp() {
+		t.Errorf("Unexpected last write.\nexpected: %v\nreceived: %v (%+v)",
+			expected, received, err)
+	}
+}
+
+// Tests that the store keeps only a server-side hash of the password hash, which
+// cannot be used to log in.
+func TestStore_AddUser_Hashed(t *testing.T) {
+	s := NewMemoryStore(0)
+	salt := []byte("aliceSalt")
+	passwordHash := pb.HashRemoteSyncPassword([]byte("password"), salt)
+	if err := s.AddUser("alice", salt, passwordHash); err != nil {
+		t.Fatalf("Failed to add user: %+v", err)
+	}
+
+	stored := s.auth.users["alice"].passwordHash
+	if bytes.Equal(stored, passwordHash) {
+		t.Error("Password hash stored as sent by the client")
+	}
+	_, err := s.Login(&pb.RsAuthenticationRequest{
+		Username: "alice", PasswordHash: stored, Salt: salt})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Logged in with the stored hash: %+v", err)
+	}
+	login(t, s, "alice")
+}
+
+// Error path: Tests that both stores reject writing a file where a directory
+// exists and below an existing file.
+func TestStore_Write_FileDirConflict(t *testing.T) {
+	for name, s := range newTestStores(t, 0) {
+		token := login(t, s, "alice")
+		write := func(path string) error {
+			_, err := s.Write(&pb.RsWriteRequest{Path: path, Token: token})
+			return err
+		}
+
+		if err := write("a"); err != nil {
+			t.Fatalf("%s: Write error: %+v", name, err)
+		}
+		if err := write("a/b"); err == nil {
+			t.Errorf("%s: wrote below a file", name)
+		}
+		if err := write("c/d"); err != nil {
+			t.Fatalf("%s: Write error: %+v", name, err)
+		}
+		if err := write("c"); err == nil {
+			t.Errorf("%s: wrote over a directory", name)
+		}
+		if err := write("a"); err != nil {
+			t.Errorf("%s: failed to overwrite file: %+v", name, err)
+		}
+	}
+}
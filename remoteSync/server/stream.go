@@ -0,0 +1,95 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains remote sync streaming gRPC endpoints
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc/metadata"
+)
+
+// WriteStream receives the chunks of a serialized pb.RsWriteRequest,
+// verifies the hash of the assembled data against the streaming header, and
+// passes the request to the handler.
+func (rc *Comms) WriteStream(stream pb.RemoteSync_WriteStreamServer) error {
+	// Obtain the headers from the client metadata
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	if !ok {
+		return errors.New("unable to retrieve meta data / header")
+	}
+
+	hashHeader := md.Get(pb.DataHashHeader)
+	if len(md.Get(pb.ChunkHeader)) == 0 || len(hashHeader) == 0 {
+		return errors.Errorf(pb.NoStreamingHeaderErr, "client")
+	}
+
+	// Receive and verify the chunks
+	chunks, err := pb.ReceiveChunks(md, stream.Recv)
+	if err != nil {
+		return err
+	}
+
+	// Assemble and verify the request
+	msg := &pb.RsWriteRequest{}
+	if err = pb.AssembleChunksIntoResponse(chunks, msg); err != nil {
+		return errors.Errorf("Failed to assemble chunks: %+v", err)
+	}
+	if err = pb.VerifyDataHashHeader(hashHeader[0], msg.GetData()); err != nil {
+		return err
+	}
+
+	ack, err := rc.handler.WriteStream(msg)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(ack)
+}
+
+// ReadStream gets the response for the read request from the handler and
+// streams it in chunks, along with a header containing the number of chunks
+// and the hash of the data.
+func (rc *Comms) ReadStream(msg *pb.RsReadRequest, stream pb.RemoteSync_ReadStreamServer) error {
+	// Get response from higher level
+	response, err := rc.handler.ReadStream(msg)
+	if err != nil {
+		return err
+	}
+
+	// Split response into streamable chunks, compressed if supported
+	incoming, _ := metadata.FromIncomingContext(stream.Context())
+	compression := pb.NegotiateChunkCompression(incoming)
+	chunks, err := pb.SplitCompressedResponseIntoChunks(response, compression)
+	if err != nil {
+		return err
+	}
+
+	// Send a header informing client-side of the total number of chunks, the
+	// hash of the data and the compression used
+	md := pb.NewChunkStreamHeader(chunks)
+	md.Set(pb.DataHashHeader, pb.EncodeDataHashHeader(response.GetData()))
+	if compression != "" {
+		md.Set(pb.ChunkCompressionHeader, compression)
+	}
+	if err = stream.SendHeader(md); err != nil {
+		return errors.Errorf("Failed to send streaming header: %v", err)
+	}
+
+	// Stream each chunk individually
+	for i, chunk := range chunks {
+		err = stream.Send(chunk)
+		if err != nil {
+			return errors.Errorf("Failed to send chunk (%d/%d) for "+
+				"path %q: %v", i, len(chunks), msg.GetPath(), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the remote sync change watch endpoint and the event publisher used
+// by implementations to notify watchers of changes
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	jww "github.com/spf13/jwalterweatherman"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"google.golang.org/grpc/metadata"
+	"strings"
+	"sync"
+)
+
+// watchBufferSize is the number of events buffered for each watcher. If a
+// watcher falls this far behind, its stream is closed so that publishing never
+// blocks; the client is expected to reconnect and resynchronise.
+const watchBufferSize = 256
+
+// Error messages.
+const (
+	watcherOverflowErr = "watcher for prefix %q fell more than %d events " +
+		"behind; closing stream"
+)
+
+// watcher is a single open Watch stream.
+type watcher struct {
+	namespace string
+	prefix    string
+	events    chan *pb.RsChangeEvent
+
+	// overflow is closed when the watcher's buffer is full and events have
+	// been dropped
+	overflow     chan struct{}
+	overflowOnce sync.Once
+}
+
+// watchers tracks all open Watch streams.
+type watchers struct {
+	list map[*watcher]struct{}
+	mux  sync.RWMutex
+}
+
+// newWatchers initialises an empty watchers list.
+func newWatchers() *watchers {
+	return &watchers{list: make(map[*watcher]struct{})}
+}
+
+// add registers a new watcher for events in the namespace with paths starting
+// with the prefix.
+func (w *watchers) add(namespace, prefix string) *watcher {
+	wt := &watcher{
+		namespace: namespace,
+		prefix:    prefix,
+		events:    make(chan *pb.RsChangeEvent, watchBufferSize),
+		overflow:  make(chan struct{}),
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.list[wt] = struct{}{}
+	return wt
+}
+
+// remove unregisters the watcher.
+func (w *watchers) remove(wt *watcher) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	delete(w.list, wt)
+}
+
+// publish delivers the event to every matching watcher without blocking.
+func (w *watchers) publish(namespace string, event *pb.RsChangeEvent) {
+	w.mux.RLock()
+	defer w.mux.RUnlock()
+
+	for wt := range w.list {
+		if wt.namespace != namespace ||
+			!strings.HasPrefix(event.GetPath(), wt.prefix) {
+			continue
+		}
+
+		select {
+		case wt.events <- event:
+		default:
+			wt.overflowOnce.Do(func() { close(wt.overflow) })
+		}
+	}
+}
+
+// PublishChange notifies all open Watch streams in the namespace whose prefix
+// matches the path of the event. The namespace must match the one returned by
+// Handler.Watch for the watcher to receive the event. Publishing never blocks.
+func (rc *Comms) PublishChange(namespace string, event *pb.RsChangeEvent) {
+	rc.watchers.publish(namespace, event)
+}
+
+// Watch streams change events published with Comms.PublishChange to the client
+// until the client closes the stream.
+func (rc *Comms) Watch(msg *pb.RsWatchRequest, stream pb.RemoteSync_WatchServer) error {
+	// Authorize the watch and get the namespace it covers
+	namespace, err := rc.handler.Watch(msg)
+	if err != nil {
+		return err
+	}
+
+	wt := rc.watchers.add(namespace, msg.GetPathPrefix())
+	defer rc.watchers.remove(wt)
+
+	// Send a header to confirm to the client that the watch has been
+	// registered
+	md := metadata.Pairs(pb.WatchOpenedHeader, "true")
+	if err = stream.SendHeader(md); err != nil {
+		return errors.Errorf("Failed to send streaming header: %v", err)
+	}
+
+	jww.DEBUG.Printf("Opened remote sync watch for prefix %q",
+		msg.GetPathPrefix())
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			jww.DEBUG.Printf("Closed remote sync watch for prefix %q",
+				msg.GetPathPrefix())
+			return nil
+		case <-wt.overflow:
+			return errors.Errorf(
+				watcherOverflowErr, msg.GetPathPrefix(), watchBufferSize)
+		case event := <-wt.events:
+			if err = stream.Send(event); err != nil {
+				return errors.Errorf("Failed to send change event for "+
+					"path %q: %v", event.GetPath(), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+// Contains the middleware wrapper for the User Discovery Handler
+
+package udb
+
+import (
+	"gitlab.com/elixxir/comms/middleware"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+)
+
+// WithMiddleware returns a Handler that calls each method of the handler
+// through the middleware chain. The endpoint name of each call is the name of
+// the Handler method.
+func WithMiddleware(handler Handler, chain middleware.Chain) Handler {
+	return &middlewareHandler{handler: handler, chain: chain}
+}
+
+// middlewareHandler wraps a Handler with a middleware.Chain.
+type middlewareHandler struct {
+	handler Handler
+	chain   middleware.Chain
+}
+
+// invoke calls the handle function for the endpoint through the chain.
+func (m *middlewareHandler) invoke(
+	endpoint string, request interface{}, handle func() error) error {
+	return m.chain.Invoke(
+		&middleware.Call{Endpoint: endpoint, Request: request}, handle)
+}
+
+func (m *middlewareHandler) RegisterUser(
+	registration *pb.UDBUserRegistration) (ack *messages.Ack, err error) {
+	err = m.invoke("RegisterUser", registration, func() error {
+		ack, err = m.handler.RegisterUser(registration)
+		return err
+	})
+	return ack, err
+}
+
+func (m *middlewareHandler) RemoveUser(request *pb.FactRemovalRequest) (
+	ack *messages.Ack, err error) {
+	err = m.invoke("RemoveUser", request, func() error {
+		ack, err = m.handler.RemoveUser(request)
+		return err
+	})
+	return ack, err
+}
+
+func (m *middlewareHandler) RegisterFact(msg *pb.FactRegisterRequest) (
+	response *pb.FactRegisterResponse, err error) {
+	err = m.invoke("RegisterFact", msg, func() error {
+		response, err = m.handler.RegisterFact(msg)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) ConfirmFact(msg *pb.FactConfirmRequest) (
+	ack *messages.Ack, err error) {
+	err = m.invoke("ConfirmFact", msg, func() error {
+		ack, err = m.handler.ConfirmFact(msg)
+		return err
+	})
+	return ack, err
+}
+
+func (m *middlewareHandler) RemoveFact(request *pb.FactRemovalRequest) (
+	ack *messages.Ack, err error) {
+	err = m.invoke("RemoveFact", request, func() error {
+		ack, err = m.handler.RemoveFact(request)
+		return err
+	})
+	return ack, err
+}
+
+func (m *middlewareHandler) RequestChannelLease(
+	request *pb.ChannelLeaseRequest) (
+	response *pb.ChannelLeaseResponse, err error) {
+	err = m.invoke("RequestChannelLease", request, func() error {
+		response, err = m.handler.RequestChannelLease(request)
+		return err
+	})
+	return response, err
+}
+
+func (m *middlewareHandler) ValidateUsername(
+	request *pb.UsernameValidationRequest) (
+	response *pb.UsernameValidation, err error) {
+	err = m.invoke("ValidateUsername", request, func() error {
+		response, err = m.handler.ValidateUsername(request)
+		return err
+	})
+	return response, err
+}
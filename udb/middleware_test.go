@@ -0,0 +1,68 @@
+////////////////////////////////////////////////////////////////////////////////
+// Copyright © 2024 xx foundation                                             //
+//                                                                            //
+// Use of this source code is governed by a license that can be found in the  //
+// LICENSE file.                                                              //
+////////////////////////////////////////////////////////////////////////////////
+
+package udb
+
+import (
+	"github.com/pkg/errors"
+	"gitlab.com/elixxir/comms/middleware"
+	pb "gitlab.com/elixxir/comms/mixmessages"
+	"gitlab.com/xx_network/comms/messages"
+	"testing"
+	"time"
+)
+
+// Tests that calls to a Handler wrapped with WithMiddleware pass the endpoint
+// name and request to the hooks and return the handler's response, and that
+// errors from the handler reach both the After hook and the caller.
+func TestWithMiddleware(t *testing.T) {
+	handlerErr := errors.New("handler error")
+	ack := &messages.Ack{}
+	impl := NewImplementation()
+	impl.Functions.RegisterUser = func(
+		*pb.UDBUserRegistration) (*messages.Ack, error) {
+		return ack, nil
+	}
+	impl.Functions.RemoveUser = func(
+		*pb.FactRemovalRequest) (*messages.Ack, error) {
+		return nil, handlerErr
+	}
+
+	var before []*middleware.Call
+	var afterErrs []error
+	handler := WithMiddleware(impl, middleware.NewChain(middleware.Middleware{
+		Before: func(call *middleware.Call) error {
+			before = append(before, call)
+			return nil
+		},
+		After: func(call *middleware.Call, latency time.Duration, err error) {
+			afterErrs = append(afterErrs, err)
+		},
+	}))
+
+	registration := &pb.UDBUserRegistration{UID: []byte("user")}
+	if resp, err := handler.RegisterUser(registration); err != nil ||
+		resp != ack {
+		t.Errorf("Unexpected response: %v, %+v", resp, err)
+	}
+	removal := &pb.FactRemovalRequest{UID: []byte("user")}
+	if _, err := handler.RemoveUser(removal); err != handlerErr {
+		t.Errorf("Unexpected error: %+v", err)
+	}
+
+	if len(before) != 2 || len(afterErrs) != 2 {
+		t.Fatalf("Expected two calls to each hook, received %d and %d.",
+			len(before), len(afterErrs))
+	}
+	if before[0].Endpoint != "RegisterUser" || before[0].Request != registration ||
+		before[1].Endpoint != "RemoveUser" || before[1].Request != removal {
+		t.Errorf("Unexpected calls: %+v, %+v", before[0], before[1])
+	}
+	if afterErrs[0] != nil || afterErrs[1] != handlerErr {
+		t.Errorf("Unexpected errors in After hook: %v", afterErrs)
+	}
+}